@@ -20,6 +20,7 @@ import (
 	ipd "github.com/hyperledger/fabric-admin-sdk/internal/policydsl"
 	"github.com/hyperledger/fabric-admin-sdk/internal/protoutil"
 	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	mspprotos "github.com/hyperledger/fabric-protos-go-apiv2/msp"
 	pb "github.com/hyperledger/fabric-protos-go-apiv2/peer"
 	"google.golang.org/protobuf/proto"
 )
@@ -88,7 +89,7 @@ func AddPolicies(cg *cb.ConfigGroup, policyMap map[string]*genesisconfig.Policy,
 	}
 
 	for policyName, policy := range policyMap {
-		configPolicy, err := newConfigPolicy(policy, modPolicy)
+		configPolicy, err := NewConfigPolicy(policy, modPolicy)
 		if err != nil {
 			return err
 		}
@@ -98,7 +99,8 @@ func AddPolicies(cg *cb.ConfigGroup, policyMap map[string]*genesisconfig.Policy,
 	return nil
 }
 
-func newConfigPolicy(policy *genesisconfig.Policy, modPolicy string) (*cb.ConfigPolicy, error) {
+// NewConfigPolicy returns a config policy for a signature or implicit meta policy rule, with the specified mod_policy.
+func NewConfigPolicy(policy *genesisconfig.Policy, modPolicy string) (*cb.ConfigPolicy, error) {
 	switch policy.Type {
 	case ImplicitMetaPolicyType:
 		imp, err := ipc.ImplicitMetaFromString(policy.Rule)
@@ -195,9 +197,35 @@ func NewChannelGroup(conf *genesisconfig.Profile) (*cb.ConfigGroup, error) {
 // NewOrdererGroup returns the orderer component of the channel configuration.  It defines parameters of the ordering service
 // about how large blocks should be, how frequently they should be emitted, etc. as well as the organizations of the ordering network.
 // It sets the mod_policy of all elements to "Admins".  This group is always present in any channel configuration.
-//
-//nolint:cyclop
 func NewOrdererGroup(conf *genesisconfig.Orderer) (*cb.ConfigGroup, error) {
+	var consensusMetadata []byte
+	var consenterProtos []*cb.Consenter
+	var err error
+
+	switch conf.OrdererType {
+	case ConsensusTypeSolo:
+	case ConsensusTypeEtcdRaft:
+		if consensusMetadata, err = icc.MarshalEtcdRaftMetadata(conf.EtcdRaft); err != nil {
+			return nil, fmt.Errorf("cannot marshal metadata for orderer type %s: %w", ConsensusTypeEtcdRaft, err)
+		}
+	case ConsensusTypeBFT:
+		if consenterProtos, err = consenterProtosFromConfig(conf.ConsenterMapping); err != nil {
+			return nil, fmt.Errorf("cannot load consenter config for orderer type %s: %w", ConsensusTypeBFT, err)
+		}
+		if consensusMetadata, err = icc.MarshalBFTOptions(conf.SmartBFT); err != nil {
+			return nil, fmt.Errorf("consenter options read failed with error %w for orderer type %s", err, ConsensusTypeBFT)
+		}
+	default:
+		return nil, fmt.Errorf("unknown orderer type: %s", conf.OrdererType)
+	}
+
+	return NewOrdererGroupWithConsensus(conf, consensusMetadata, consenterProtos)
+}
+
+// NewOrdererGroupWithConsensus returns the orderer component of the channel configuration in the same way as
+// NewOrdererGroup, but uses the supplied consensus metadata and BFT consenters instead of loading them from the
+// certificate paths in the orderer configuration.
+func NewOrdererGroupWithConsensus(conf *genesisconfig.Orderer, consensusMetadata []byte, consenterProtos []*cb.Consenter) (*cb.ConfigGroup, error) {
 	ordererGroup := NewConfigGroup()
 	if err := AddOrdererPolicies(ordererGroup, conf.Policies, icc.AdminsPolicyKey); err != nil {
 		return nil, fmt.Errorf("error adding policies to orderer group %w", err)
@@ -214,28 +242,10 @@ func NewOrdererGroup(conf *genesisconfig.Orderer) (*cb.ConfigGroup, error) {
 		addValue(ordererGroup, icc.CapabilitiesValue(conf.Capabilities), icc.AdminsPolicyKey)
 	}
 
-	var consensusMetadata []byte
-	var err error
-
-	switch conf.OrdererType {
-	case ConsensusTypeSolo:
-	case ConsensusTypeEtcdRaft:
-		if consensusMetadata, err = icc.MarshalEtcdRaftMetadata(conf.EtcdRaft); err != nil {
-			return nil, fmt.Errorf("cannot marshal metadata for orderer type %s: %w", ConsensusTypeEtcdRaft, err)
-		}
-	case ConsensusTypeBFT:
-		consenterProtos, err := consenterProtosFromConfig(conf.ConsenterMapping)
-		if err != nil {
-			return nil, fmt.Errorf("cannot load consenter config for orderer type %s: %w", ConsensusTypeBFT, err)
-		}
+	if conf.OrdererType == ConsensusTypeBFT {
 		addValue(ordererGroup, icc.OrderersValue(consenterProtos), icc.AdminsPolicyKey)
-		if consensusMetadata, err = icc.MarshalBFTOptions(conf.SmartBFT); err != nil {
-			return nil, fmt.Errorf("consenter options read failed with error %w for orderer type %s", err, ConsensusTypeBFT)
-		}
 		// Overwrite policy manually by computing it from the consenters
 		ipc.EncodeBFTBlockVerificationPolicy(consenterProtos, ordererGroup)
-	default:
-		return nil, fmt.Errorf("unknown orderer type: %s", conf.OrdererType)
 	}
 
 	addValue(ordererGroup, icc.ConsensusTypeValue(conf.OrdererType, consensusMetadata), icc.AdminsPolicyKey)
@@ -252,6 +262,16 @@ func NewOrdererGroup(conf *genesisconfig.Orderer) (*cb.ConfigGroup, error) {
 	return ordererGroup, nil
 }
 
+// loadMSPConfig returns the in-memory MSP definition for an organization if one is supplied, otherwise it loads the
+// MSP definition from the organization's MSP directory.
+func loadMSPConfig(conf *genesisconfig.Organization) (*mspprotos.MSPConfig, error) {
+	if conf.MSPConfig != nil {
+		return conf.MSPConfig, nil
+	}
+
+	return msp.GetVerifyingMspConfig(conf.MSPDir, conf.ID, conf.MSPType)
+}
+
 // NewConsortiumOrgGroup returns an org component of the channel configuration.  It defines the crypto material for the
 // organization (its MSP).  It sets the mod_policy of all elements to "Admins".
 func NewConsortiumOrgGroup(conf *genesisconfig.Organization) (*cb.ConfigGroup, error) {
@@ -262,7 +282,7 @@ func NewConsortiumOrgGroup(conf *genesisconfig.Organization) (*cb.ConfigGroup, e
 		return consortiumsOrgGroup, nil
 	}
 
-	mspConfig, err := msp.GetVerifyingMspConfig(conf.MSPDir, conf.ID, conf.MSPType)
+	mspConfig, err := loadMSPConfig(conf)
 	if err != nil {
 		return nil, fmt.Errorf("1 - Error loading MSP configuration for org: %s %w", conf.Name, err)
 	}
@@ -286,7 +306,7 @@ func NewOrdererOrgGroup(conf *genesisconfig.Organization) (*cb.ConfigGroup, erro
 		return ordererOrgGroup, nil
 	}
 
-	mspConfig, err := msp.GetVerifyingMspConfig(conf.MSPDir, conf.ID, conf.MSPType)
+	mspConfig, err := loadMSPConfig(conf)
 	if err != nil {
 		return nil, fmt.Errorf("1 - Error loading MSP configuration for org: %s %w", conf.Name, err)
 	}
@@ -342,7 +362,7 @@ func NewApplicationOrgGroup(conf *genesisconfig.Organization) (*cb.ConfigGroup,
 		return applicationOrgGroup, nil
 	}
 
-	mspConfig, err := msp.GetVerifyingMspConfig(conf.MSPDir, conf.ID, conf.MSPType)
+	mspConfig, err := loadMSPConfig(conf)
 	if err != nil {
 		return nil, fmt.Errorf("1 - Error loading MSP configuration for org %s %w", conf.Name, err)
 	}
@@ -17,6 +17,7 @@ import (
 
 	"github.com/hyperledger/fabric-admin-sdk/internal/configtxgen/viperutil"
 	"github.com/hyperledger/fabric-admin-sdk/internal/msp"
+	mspprotos "github.com/hyperledger/fabric-protos-go-apiv2/msp"
 	"github.com/hyperledger/fabric-protos-go-apiv2/orderer/smartbft"
 
 	"github.com/hyperledger/fabric-protos-go-apiv2/orderer/etcdraft"
@@ -141,6 +142,10 @@ type Organization struct {
 	// SkipAsForeign indicates that this org definition is actually unknown to this
 	// instance of the tool, so, parsing of this org's parameters should be ignored.
 	SkipAsForeign bool
+
+	// MSPConfig is an in-memory MSP definition. If set, it is used in place of
+	// loading the MSP definition from MSPDir.
+	MSPConfig *mspprotos.MSPConfig `yaml:"-"`
 }
 
 // AnchorPeer encodes the necessary fields to identify an anchor peer.
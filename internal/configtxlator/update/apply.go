@@ -0,0 +1,111 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package update
+
+import (
+	"errors"
+
+	"github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"google.golang.org/protobuf/proto"
+)
+
+func applyPoliciesMapUpdate(original, writeSet map[string]*common.ConfigPolicy, replaceMembers bool) map[string]*common.ConfigPolicy {
+	result := make(map[string]*common.ConfigPolicy)
+	if !replaceMembers {
+		copyMap(original, result)
+	}
+
+	for policyName, writePolicy := range writeSet {
+		originalPolicy, ok := original[policyName]
+		if ok && originalPolicy.GetVersion() == writePolicy.GetVersion() {
+			// Unmodified policies are included in the write set only to retain map membership
+			result[policyName] = originalPolicy
+			continue
+		}
+		result[policyName] = writePolicy
+	}
+
+	return result
+}
+
+func applyValuesMapUpdate(original, writeSet map[string]*common.ConfigValue, replaceMembers bool) map[string]*common.ConfigValue {
+	result := make(map[string]*common.ConfigValue)
+	if !replaceMembers {
+		copyMap(original, result)
+	}
+
+	for valueName, writeValue := range writeSet {
+		originalValue, ok := original[valueName]
+		if ok && originalValue.GetVersion() == writeValue.GetVersion() {
+			// Unmodified values are included in the write set only to retain map membership
+			result[valueName] = originalValue
+			continue
+		}
+		result[valueName] = writeValue
+	}
+
+	return result
+}
+
+func applyGroupsMapUpdate(original, writeSet map[string]*common.ConfigGroup, replaceMembers bool) map[string]*common.ConfigGroup {
+	result := make(map[string]*common.ConfigGroup)
+	if !replaceMembers {
+		copyMap(original, result)
+	}
+
+	for groupName, writeGroup := range writeSet {
+		originalGroup, ok := original[groupName]
+		if !ok {
+			result[groupName] = writeGroup
+			continue
+		}
+		result[groupName] = applyGroupUpdate(originalGroup, writeGroup)
+	}
+
+	return result
+}
+
+func applyGroupUpdate(original, writeSet *common.ConfigGroup) *common.ConfigGroup {
+	// A group whose version is unchanged retains its existing members, with only the members present in the write set
+	// updated. A group whose version is incremented has its membership replaced by the members of the write set.
+	replaceMembers := original.GetVersion() != writeSet.GetVersion()
+
+	modPolicy := original.GetModPolicy()
+	if replaceMembers {
+		modPolicy = writeSet.GetModPolicy()
+	}
+
+	return &common.ConfigGroup{
+		Version:   writeSet.GetVersion(),
+		ModPolicy: modPolicy,
+		Policies:  applyPoliciesMapUpdate(original.GetPolicies(), writeSet.GetPolicies(), replaceMembers),
+		Values:    applyValuesMapUpdate(original.GetValues(), writeSet.GetValues(), replaceMembers),
+		Groups:    applyGroupsMapUpdate(original.GetGroups(), writeSet.GetGroups(), replaceMembers),
+	}
+}
+
+// Apply returns the config resulting from applying the write set of a config update to the original config. The
+// config update is not validated against the read set or channel policies, and the original config is not modified.
+func Apply(original *common.Config, configUpdate *common.ConfigUpdate) (*common.Config, error) {
+	if original.GetChannelGroup() == nil {
+		return nil, errors.New("no channel group included for original config")
+	}
+
+	if configUpdate.GetWriteSet() == nil {
+		return nil, errors.New("no write set included for config update")
+	}
+
+	channelGroup, ok := proto.Clone(applyGroupUpdate(original.GetChannelGroup(), configUpdate.GetWriteSet())).(*common.ConfigGroup)
+	if !ok {
+		return nil, errors.New("failed to copy channel group")
+	}
+
+	return &common.Config{
+		Sequence:     original.GetSequence() + 1,
+		ChannelGroup: channelGroup,
+	}, nil
+}
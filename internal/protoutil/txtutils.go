@@ -4,9 +4,11 @@ import (
 	"bytes"
 	"crypto/rand"
 	"crypto/sha256"
+	"encoding/asn1"
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"math/big"
 
 	"github.com/hyperledger/fabric-protos-go-apiv2/msp"
 
@@ -154,6 +156,33 @@ func BlockDataHash(b *common.BlockData) []byte {
 	return sum[:]
 }
 
+type asn1Header struct {
+	Number       *big.Int
+	PreviousHash []byte
+	DataHash     []byte
+}
+
+// BlockHeaderBytes returns the ASN.1 encoding of a block header, which is used to compute the block hash.
+func BlockHeaderBytes(b *common.BlockHeader) []byte {
+	result, err := asn1.Marshal(asn1Header{
+		Number:       new(big.Int).SetUint64(b.GetNumber()),
+		PreviousHash: b.GetPreviousHash(),
+		DataHash:     b.GetDataHash(),
+	})
+	if err != nil {
+		// Errors should only arise for types which cannot be encoded, since the asn1Header type is known a-priori
+		// to contain only encodable types, an error here is fatal and should not be propagated
+		panic(err)
+	}
+	return result
+}
+
+// BlockHeaderHash returns the hash of a block header, which is the previous hash recorded by the next block.
+func BlockHeaderHash(b *common.BlockHeader) []byte {
+	sum := sha256.Sum256(BlockHeaderBytes(b))
+	return sum[:]
+}
+
 // NewBlock constructs a block with no data and no metadata.
 func NewBlock(seqNum uint64, previousHash []byte) *common.Block {
 	block := &common.Block{}
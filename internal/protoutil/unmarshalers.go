@@ -4,6 +4,10 @@ import (
 	"fmt"
 
 	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"github.com/hyperledger/fabric-protos-go-apiv2/ledger/rwset"
+	"github.com/hyperledger/fabric-protos-go-apiv2/ledger/rwset/kvrwset"
+	"github.com/hyperledger/fabric-protos-go-apiv2/msp"
+	"github.com/hyperledger/fabric-protos-go-apiv2/peer"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -42,3 +46,102 @@ func UnmarshalConfigUpdateEnvelope(data []byte) (*cb.ConfigUpdateEnvelope, error
 	}
 	return configUpdateEnvelope, nil
 }
+
+// UnmarshalBlock unmarshals bytes to a Block
+func UnmarshalBlock(encoded []byte) (*cb.Block, error) {
+	block := &cb.Block{}
+	if err := proto.Unmarshal(encoded, block); err != nil {
+		return nil, fmt.Errorf("error unmarshaling Block: %w", err)
+	}
+	return block, nil
+}
+
+// UnmarshalSerializedIdentity unmarshals bytes to a SerializedIdentity
+func UnmarshalSerializedIdentity(encoded []byte) (*msp.SerializedIdentity, error) {
+	serializedIdentity := &msp.SerializedIdentity{}
+	if err := proto.Unmarshal(encoded, serializedIdentity); err != nil {
+		return nil, fmt.Errorf("error unmarshaling SerializedIdentity: %w", err)
+	}
+	return serializedIdentity, nil
+}
+
+// UnmarshalTransaction unmarshals bytes to a Transaction
+func UnmarshalTransaction(encoded []byte) (*peer.Transaction, error) {
+	transaction := &peer.Transaction{}
+	if err := proto.Unmarshal(encoded, transaction); err != nil {
+		return nil, fmt.Errorf("error unmarshaling Transaction: %w", err)
+	}
+	return transaction, nil
+}
+
+// UnmarshalChaincodeActionPayload unmarshals bytes to a ChaincodeActionPayload
+func UnmarshalChaincodeActionPayload(encoded []byte) (*peer.ChaincodeActionPayload, error) {
+	actionPayload := &peer.ChaincodeActionPayload{}
+	if err := proto.Unmarshal(encoded, actionPayload); err != nil {
+		return nil, fmt.Errorf("error unmarshaling ChaincodeActionPayload: %w", err)
+	}
+	return actionPayload, nil
+}
+
+// UnmarshalChaincodeInvocationSpec unmarshals bytes to a ChaincodeInvocationSpec
+func UnmarshalChaincodeInvocationSpec(encoded []byte) (*peer.ChaincodeInvocationSpec, error) {
+	invocationSpec := &peer.ChaincodeInvocationSpec{}
+	if err := proto.Unmarshal(encoded, invocationSpec); err != nil {
+		return nil, fmt.Errorf("error unmarshaling ChaincodeInvocationSpec: %w", err)
+	}
+	return invocationSpec, nil
+}
+
+// UnmarshalProposalResponsePayload unmarshals bytes to a ProposalResponsePayload
+func UnmarshalProposalResponsePayload(encoded []byte) (*peer.ProposalResponsePayload, error) {
+	responsePayload := &peer.ProposalResponsePayload{}
+	if err := proto.Unmarshal(encoded, responsePayload); err != nil {
+		return nil, fmt.Errorf("error unmarshaling ProposalResponsePayload: %w", err)
+	}
+	return responsePayload, nil
+}
+
+// UnmarshalChaincodeAction unmarshals bytes to a ChaincodeAction
+func UnmarshalChaincodeAction(encoded []byte) (*peer.ChaincodeAction, error) {
+	action := &peer.ChaincodeAction{}
+	if err := proto.Unmarshal(encoded, action); err != nil {
+		return nil, fmt.Errorf("error unmarshaling ChaincodeAction: %w", err)
+	}
+	return action, nil
+}
+
+// UnmarshalChaincodeEvent unmarshals bytes to a ChaincodeEvent
+func UnmarshalChaincodeEvent(encoded []byte) (*peer.ChaincodeEvent, error) {
+	event := &peer.ChaincodeEvent{}
+	if err := proto.Unmarshal(encoded, event); err != nil {
+		return nil, fmt.Errorf("error unmarshaling ChaincodeEvent: %w", err)
+	}
+	return event, nil
+}
+
+// UnmarshalTxReadWriteSet unmarshals bytes to a TxReadWriteSet
+func UnmarshalTxReadWriteSet(encoded []byte) (*rwset.TxReadWriteSet, error) {
+	txReadWriteSet := &rwset.TxReadWriteSet{}
+	if err := proto.Unmarshal(encoded, txReadWriteSet); err != nil {
+		return nil, fmt.Errorf("error unmarshaling TxReadWriteSet: %w", err)
+	}
+	return txReadWriteSet, nil
+}
+
+// UnmarshalKVRWSet unmarshals bytes to a KVRWSet
+func UnmarshalKVRWSet(encoded []byte) (*kvrwset.KVRWSet, error) {
+	kvReadWriteSet := &kvrwset.KVRWSet{}
+	if err := proto.Unmarshal(encoded, kvReadWriteSet); err != nil {
+		return nil, fmt.Errorf("error unmarshaling KVRWSet: %w", err)
+	}
+	return kvReadWriteSet, nil
+}
+
+// UnmarshalHashedRWSet unmarshals bytes to a HashedRWSet
+func UnmarshalHashedRWSet(encoded []byte) (*kvrwset.HashedRWSet, error) {
+	hashedReadWriteSet := &kvrwset.HashedRWSet{}
+	if err := proto.Unmarshal(encoded, hashedReadWriteSet); err != nil {
+		return nil, fmt.Errorf("error unmarshaling HashedRWSet: %w", err)
+	}
+	return hashedReadWriteSet, nil
+}
@@ -0,0 +1,416 @@
+// Package block decodes ledger blocks into typed structures for inspection, including transaction headers, creators,
+// chaincode invocations, read/write sets, endorsers and validation codes.
+package block
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hyperledger/fabric-admin-sdk/internal/protoutil"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"github.com/hyperledger/fabric-protos-go-apiv2/ledger/rwset"
+	"github.com/hyperledger/fabric-protos-go-apiv2/ledger/rwset/kvrwset"
+	"github.com/hyperledger/fabric-protos-go-apiv2/peer"
+)
+
+// Block is a decoded ledger block.
+type Block struct {
+	// Number of the block within the ledger.
+	Number uint64
+
+	// PreviousHash is the header hash of the previous block.
+	PreviousHash []byte
+
+	// DataHash is the hash of the block data recorded in the block header.
+	DataHash []byte
+
+	// HeaderHash is the hash of the block header, which is recorded as the previous hash by the next block.
+	HeaderHash []byte
+
+	// Transactions contained in the block, in block order.
+	Transactions []*Transaction
+}
+
+// Transaction is a decoded transaction envelope.
+type Transaction struct {
+	// Index of the transaction within the block.
+	Index int
+
+	// Type of the transaction, such as HeaderType_ENDORSER_TRANSACTION or HeaderType_CONFIG.
+	Type cb.HeaderType
+
+	// ChannelID of the channel to which the transaction belongs.
+	ChannelID string
+
+	// TxID is the transaction ID.
+	TxID string
+
+	// Timestamp at which the transaction was created by the client.
+	Timestamp time.Time
+
+	// ChannelHeader is the undecoded channel header, which contains additional fields.
+	ChannelHeader *cb.ChannelHeader
+
+	// Creator is the identity that signed the transaction envelope. It is nil if the envelope has no creator, as is
+	// the case for genesis blocks.
+	Creator *Identity
+
+	// ValidationCode assigned to the transaction by committing peers. Transactions in blocks obtained from the
+	// ordering service, which have not been validated, have the code TxValidationCode_NOT_VALIDATED.
+	ValidationCode peer.TxValidationCode
+
+	// Actions of an endorser transaction. Other transaction types have no actions.
+	Actions []*Action
+}
+
+// Identity is a decoded serialized identity.
+type Identity struct {
+	// MSPID of the identity.
+	MSPID string
+
+	// Credentials of the identity, typically a PEM encoded certificate.
+	Credentials []byte
+
+	// Certificate parsed from the credentials. It is nil if the credentials are not a PEM encoded X.509 certificate.
+	Certificate *x509.Certificate
+}
+
+// Action is a decoded chaincode action within an endorser transaction.
+type Action struct {
+	// Chaincode invoked by the transaction proposal. It is nil if the proposal input was not included in the
+	// transaction.
+	Chaincode *ChaincodeInvocation
+
+	// ChaincodeID of the chaincode that produced the action result.
+	ChaincodeID *peer.ChaincodeID
+
+	// Response returned by the chaincode.
+	Response *peer.Response
+
+	// Event emitted by the chaincode, or nil if no event was emitted.
+	Event *peer.ChaincodeEvent
+
+	// ReadWriteSets produced by the chaincode, one for each namespace accessed.
+	ReadWriteSets []*NamespaceReadWriteSet
+
+	// Endorsers whose endorsements are included in the transaction.
+	Endorsers []*Identity
+}
+
+// ChaincodeInvocation describes the chaincode invoked by a transaction proposal.
+type ChaincodeInvocation struct {
+	// Name of the chaincode.
+	Name string
+
+	// Args supplied to the chaincode. By convention, the first argument is the transaction function name.
+	Args [][]byte
+
+	// IsInit is true if the invocation was a chaincode initialization.
+	IsInit bool
+}
+
+// NamespaceReadWriteSet is the public read/write set for a namespace, along with hashes of private data collection
+// read/write sets.
+type NamespaceReadWriteSet struct {
+	// Namespace, which is typically a chaincode name.
+	Namespace string
+
+	// ReadWriteSet of public state.
+	ReadWriteSet *kvrwset.KVRWSet
+
+	// CollectionHashedReadWriteSets contains hashes of private data read and written for each collection.
+	CollectionHashedReadWriteSets []*CollectionHashedReadWriteSet
+}
+
+// CollectionHashedReadWriteSet contains hashes of the private data read and written in a collection.
+type CollectionHashedReadWriteSet struct {
+	// CollectionName of the private data collection.
+	CollectionName string
+
+	// HashedReadWriteSet of private data keys and values.
+	HashedReadWriteSet *kvrwset.HashedRWSet
+
+	// PrivateReadWriteSetHash is the hash of the complete private read/write set for the collection.
+	PrivateReadWriteSetHash []byte
+}
+
+// Decode a ledger block.
+func Decode(block *cb.Block) (*Block, error) {
+	if block.GetHeader() == nil {
+		return nil, errors.New("block contains no header")
+	}
+
+	result := &Block{
+		Number:       block.GetHeader().GetNumber(),
+		PreviousHash: block.GetHeader().GetPreviousHash(),
+		DataHash:     block.GetHeader().GetDataHash(),
+		HeaderHash:   protoutil.BlockHeaderHash(block.GetHeader()),
+	}
+
+	validationCodes := transactionsFilter(block)
+
+	for i, envelopeBytes := range block.GetData().GetData() {
+		envelope, err := protoutil.UnmarshalEnvelope(envelopeBytes)
+		if err != nil {
+			return nil, fmt.Errorf("transaction %d: %w", i, err)
+		}
+
+		transaction, err := DecodeTransaction(envelope)
+		if err != nil {
+			return nil, fmt.Errorf("transaction %d: %w", i, err)
+		}
+
+		transaction.Index = i
+		if i < len(validationCodes) {
+			transaction.ValidationCode = peer.TxValidationCode(validationCodes[i])
+		}
+
+		result.Transactions = append(result.Transactions, transaction)
+	}
+
+	return result, nil
+}
+
+// DecodeBytes decodes a serialized ledger block.
+func DecodeBytes(blockBytes []byte) (*Block, error) {
+	block, err := protoutil.UnmarshalBlock(blockBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return Decode(block)
+}
+
+// DecodeTransaction decodes a transaction envelope. The validation code of the returned transaction is
+// TxValidationCode_NOT_VALIDATED, since validation codes are recorded in block metadata.
+func DecodeTransaction(envelope *cb.Envelope) (*Transaction, error) {
+	payload, err := protoutil.UnmarshalPayload(envelope.GetPayload())
+	if err != nil {
+		return nil, err
+	}
+
+	if payload.GetHeader() == nil {
+		return nil, errors.New("envelope contains no header")
+	}
+
+	channelHeader, err := protoutil.UnmarshalChannelHeader(payload.GetHeader().GetChannelHeader())
+	if err != nil {
+		return nil, err
+	}
+
+	signatureHeader, err := protoutil.UnmarshalSignatureHeader(payload.GetHeader().GetSignatureHeader())
+	if err != nil {
+		return nil, err
+	}
+
+	creator, err := decodeIdentity(signatureHeader.GetCreator())
+	if err != nil {
+		return nil, fmt.Errorf("invalid creator: %w", err)
+	}
+
+	result := &Transaction{
+		Type:           cb.HeaderType(channelHeader.GetType()),
+		ChannelID:      channelHeader.GetChannelId(),
+		TxID:           channelHeader.GetTxId(),
+		ChannelHeader:  channelHeader,
+		Creator:        creator,
+		ValidationCode: peer.TxValidationCode_NOT_VALIDATED,
+	}
+	if channelHeader.GetTimestamp() != nil {
+		result.Timestamp = channelHeader.GetTimestamp().AsTime()
+	}
+
+	if result.Type == cb.HeaderType_ENDORSER_TRANSACTION {
+		result.Actions, err = decodeActions(payload.GetData())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func transactionsFilter(block *cb.Block) []byte {
+	metadata := block.GetMetadata().GetMetadata()
+	if len(metadata) <= int(cb.BlockMetadataIndex_TRANSACTIONS_FILTER) {
+		return nil
+	}
+
+	return metadata[cb.BlockMetadataIndex_TRANSACTIONS_FILTER]
+}
+
+func decodeIdentity(serializedIdentity []byte) (*Identity, error) {
+	if len(serializedIdentity) == 0 {
+		return nil, nil
+	}
+
+	id, err := protoutil.UnmarshalSerializedIdentity(serializedIdentity)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Identity{
+		MSPID:       id.GetMspid(),
+		Credentials: id.GetIdBytes(),
+	}
+
+	if block, _ := pem.Decode(id.GetIdBytes()); block != nil {
+		if certificate, err := x509.ParseCertificate(block.Bytes); err == nil {
+			result.Certificate = certificate
+		}
+	}
+
+	return result, nil
+}
+
+func decodeActions(transactionBytes []byte) ([]*Action, error) {
+	transaction, err := protoutil.UnmarshalTransaction(transactionBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*Action
+	for i, transactionAction := range transaction.GetActions() {
+		action, err := decodeAction(transactionAction)
+		if err != nil {
+			return nil, fmt.Errorf("action %d: %w", i, err)
+		}
+		result = append(result, action)
+	}
+
+	return result, nil
+}
+
+func decodeAction(transactionAction *peer.TransactionAction) (*Action, error) {
+	actionPayload, err := protoutil.UnmarshalChaincodeActionPayload(transactionAction.GetPayload())
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Action{}
+
+	result.Chaincode, err = decodeInvocation(actionPayload.GetChaincodeProposalPayload())
+	if err != nil {
+		return nil, err
+	}
+
+	endorsedAction := actionPayload.GetAction()
+	result.Endorsers, err = decodeEndorsers(endorsedAction.GetEndorsements())
+	if err != nil {
+		return nil, err
+	}
+
+	responsePayload, err := protoutil.UnmarshalProposalResponsePayload(endorsedAction.GetProposalResponsePayload())
+	if err != nil {
+		return nil, err
+	}
+
+	chaincodeAction, err := protoutil.UnmarshalChaincodeAction(responsePayload.GetExtension())
+	if err != nil {
+		return nil, err
+	}
+
+	result.ChaincodeID = chaincodeAction.GetChaincodeId()
+	result.Response = chaincodeAction.GetResponse()
+
+	if len(chaincodeAction.GetEvents()) > 0 {
+		result.Event, err = protoutil.UnmarshalChaincodeEvent(chaincodeAction.GetEvents())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	result.ReadWriteSets, err = decodeReadWriteSets(chaincodeAction.GetResults())
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func decodeEndorsers(endorsements []*peer.Endorsement) ([]*Identity, error) {
+	var result []*Identity
+	for _, endorsement := range endorsements {
+		endorser, err := decodeIdentity(endorsement.GetEndorser())
+		if err != nil {
+			return nil, fmt.Errorf("invalid endorser: %w", err)
+		}
+		if endorser != nil {
+			result = append(result, endorser)
+		}
+	}
+
+	return result, nil
+}
+
+func decodeInvocation(proposalPayloadBytes []byte) (*ChaincodeInvocation, error) {
+	proposalPayload, err := protoutil.UnmarshalChaincodeProposalPayload(proposalPayloadBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(proposalPayload.GetInput()) == 0 {
+		return nil, nil
+	}
+
+	invocationSpec, err := protoutil.UnmarshalChaincodeInvocationSpec(proposalPayload.GetInput())
+	if err != nil {
+		return nil, err
+	}
+
+	spec := invocationSpec.GetChaincodeSpec()
+	return &ChaincodeInvocation{
+		Name:   spec.GetChaincodeId().GetName(),
+		Args:   spec.GetInput().GetArgs(),
+		IsInit: spec.GetInput().GetIsInit(),
+	}, nil
+}
+
+func decodeReadWriteSets(results []byte) ([]*NamespaceReadWriteSet, error) {
+	if len(results) == 0 {
+		return nil, nil
+	}
+
+	txReadWriteSet, err := protoutil.UnmarshalTxReadWriteSet(results)
+	if err != nil {
+		return nil, err
+	}
+
+	if txReadWriteSet.GetDataModel() != rwset.TxReadWriteSet_KV {
+		return nil, fmt.Errorf("unsupported read/write set data model: %s", txReadWriteSet.GetDataModel())
+	}
+
+	var result []*NamespaceReadWriteSet
+	for _, nsReadWriteSet := range txReadWriteSet.GetNsRwset() {
+		kvReadWriteSet, err := protoutil.UnmarshalKVRWSet(nsReadWriteSet.GetRwset())
+		if err != nil {
+			return nil, fmt.Errorf("namespace %s: %w", nsReadWriteSet.GetNamespace(), err)
+		}
+
+		namespace := &NamespaceReadWriteSet{
+			Namespace:    nsReadWriteSet.GetNamespace(),
+			ReadWriteSet: kvReadWriteSet,
+		}
+
+		for _, collection := range nsReadWriteSet.GetCollectionHashedRwset() {
+			hashedReadWriteSet, err := protoutil.UnmarshalHashedRWSet(collection.GetHashedRwset())
+			if err != nil {
+				return nil, fmt.Errorf("namespace %s collection %s: %w", nsReadWriteSet.GetNamespace(), collection.GetCollectionName(), err)
+			}
+
+			namespace.CollectionHashedReadWriteSets = append(namespace.CollectionHashedReadWriteSets, &CollectionHashedReadWriteSet{
+				CollectionName:          collection.GetCollectionName(),
+				HashedReadWriteSet:      hashedReadWriteSet,
+				PrivateReadWriteSetHash: collection.GetPvtRwsetHash(),
+			})
+		}
+
+		result = append(result, namespace)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,345 @@
+package block_test
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/hyperledger/fabric-admin-sdk/internal/protoutil"
+	"github.com/hyperledger/fabric-admin-sdk/pkg/block"
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"github.com/hyperledger/fabric-protos-go-apiv2/ledger/rwset"
+	"github.com/hyperledger/fabric-protos-go-apiv2/ledger/rwset/kvrwset"
+	"github.com/hyperledger/fabric-protos-go-apiv2/msp"
+	pb "github.com/hyperledger/fabric-protos-go-apiv2/peer"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const testDataDir = "../../test/data"
+
+func ReadTestFile(elem ...string) []byte {
+	result, err := os.ReadFile(filepath.Join(append([]string{testDataDir}, elem...)...))
+	Expect(err).NotTo(HaveOccurred())
+	return result
+}
+
+func ReadAdminCertificate(org string) []byte {
+	return ReadTestFile("peerOrganizations", org, "users", "Admin@"+org, "msp", "signcerts", "Admin@"+org+"-cert.pem")
+}
+
+func MarshalProto(message proto.Message) []byte {
+	result, err := proto.Marshal(message)
+	Expect(err).NotTo(HaveOccurred())
+	return result
+}
+
+func NewSerializedIdentity(mspID string, credentials []byte) []byte {
+	return MarshalProto(&msp.SerializedIdentity{
+		Mspid:   mspID,
+		IdBytes: credentials,
+	})
+}
+
+func NewEndorserTransaction(txID string, timestamp time.Time) *cb.Envelope {
+	org1Cert := ReadAdminCertificate("org1.example.com")
+	org2Cert := ReadAdminCertificate("org2.example.com")
+
+	invocationSpec := &pb.ChaincodeInvocationSpec{
+		ChaincodeSpec: &pb.ChaincodeSpec{
+			ChaincodeId: &pb.ChaincodeID{Name: "basic"},
+			Input: &pb.ChaincodeInput{
+				Args: [][]byte{[]byte("CreateAsset"), []byte("asset1")},
+			},
+		},
+	}
+
+	readWriteSet := &rwset.TxReadWriteSet{
+		DataModel: rwset.TxReadWriteSet_KV,
+		NsRwset: []*rwset.NsReadWriteSet{
+			{
+				Namespace: "basic",
+				Rwset: MarshalProto(&kvrwset.KVRWSet{
+					Writes: []*kvrwset.KVWrite{
+						{Key: "asset1", Value: []byte("VALUE")},
+					},
+				}),
+				CollectionHashedRwset: []*rwset.CollectionHashedReadWriteSet{
+					{
+						CollectionName: "private",
+						HashedRwset: MarshalProto(&kvrwset.HashedRWSet{
+							HashedWrites: []*kvrwset.KVWriteHash{
+								{KeyHash: []byte("KEY_HASH"), ValueHash: []byte("VALUE_HASH")},
+							},
+						}),
+						PvtRwsetHash: []byte("PRIVATE_HASH"),
+					},
+				},
+			},
+		},
+	}
+
+	chaincodeAction := &pb.ChaincodeAction{
+		ChaincodeId: &pb.ChaincodeID{Name: "basic", Version: "1.0"},
+		Response:    &pb.Response{Status: 200, Payload: []byte("RESULT")},
+		Results:     MarshalProto(readWriteSet),
+		Events: MarshalProto(&pb.ChaincodeEvent{
+			ChaincodeId: "basic",
+			TxId:        txID,
+			EventName:   "AssetCreated",
+			Payload:     []byte("EVENT"),
+		}),
+	}
+
+	actionPayload := &pb.ChaincodeActionPayload{
+		ChaincodeProposalPayload: MarshalProto(&pb.ChaincodeProposalPayload{
+			Input: MarshalProto(invocationSpec),
+		}),
+		Action: &pb.ChaincodeEndorsedAction{
+			ProposalResponsePayload: MarshalProto(&pb.ProposalResponsePayload{
+				ProposalHash: []byte("PROPOSAL_HASH"),
+				Extension:    MarshalProto(chaincodeAction),
+			}),
+			Endorsements: []*pb.Endorsement{
+				{Endorser: NewSerializedIdentity("Org1MSP", org1Cert), Signature: []byte("SIGNATURE1")},
+				{Endorser: NewSerializedIdentity("Org2MSP", org2Cert), Signature: []byte("SIGNATURE2")},
+			},
+		},
+	}
+
+	transaction := &pb.Transaction{
+		Actions: []*pb.TransactionAction{
+			{Payload: MarshalProto(actionPayload)},
+		},
+	}
+
+	payload := &cb.Payload{
+		Header: &cb.Header{
+			ChannelHeader: MarshalProto(&cb.ChannelHeader{
+				Type:      int32(cb.HeaderType_ENDORSER_TRANSACTION),
+				ChannelId: "mychannel",
+				TxId:      txID,
+				Timestamp: timestamppb.New(timestamp),
+			}),
+			SignatureHeader: MarshalProto(&cb.SignatureHeader{
+				Creator: NewSerializedIdentity("Org1MSP", org1Cert),
+				Nonce:   []byte("NONCE"),
+			}),
+		},
+		Data: MarshalProto(transaction),
+	}
+
+	return &cb.Envelope{
+		Payload:   MarshalProto(payload),
+		Signature: []byte("SIGNATURE"),
+	}
+}
+
+func NewBlock(number uint64, envelopes ...*cb.Envelope) *cb.Block {
+	data := &cb.BlockData{}
+	for _, envelope := range envelopes {
+		data.Data = append(data.Data, MarshalProto(envelope))
+	}
+
+	metadata := make([][]byte, len(cb.BlockMetadataIndex_name))
+	metadata[cb.BlockMetadataIndex_TRANSACTIONS_FILTER] = make([]byte, len(envelopes))
+
+	return &cb.Block{
+		Header: &cb.BlockHeader{
+			Number:       number,
+			PreviousHash: []byte("PREVIOUS_HASH"),
+			DataHash:     []byte("DATA_HASH"),
+		},
+		Data:     data,
+		Metadata: &cb.BlockMetadata{Metadata: metadata},
+	}
+}
+
+var _ = Describe("Decode", func() {
+	var timestamp time.Time
+	var endorserTransaction *cb.Envelope
+
+	BeforeEach(func() {
+		timestamp = time.Unix(1700000000, 0).UTC()
+		endorserTransaction = NewEndorserTransaction("TX_ID", timestamp)
+	})
+
+	It("Block header", func() {
+		input := NewBlock(7, endorserTransaction)
+
+		actual, err := block.Decode(input)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(actual.Number).To(Equal(uint64(7)))
+		Expect(actual.PreviousHash).To(Equal([]byte("PREVIOUS_HASH")))
+		Expect(actual.DataHash).To(Equal([]byte("DATA_HASH")))
+		Expect(actual.HeaderHash).To(Equal(protoutil.BlockHeaderHash(input.GetHeader())))
+		Expect(actual.Transactions).To(HaveLen(1))
+	})
+
+	It("Transaction headers", func() {
+		actual, err := block.Decode(NewBlock(7, endorserTransaction))
+		Expect(err).NotTo(HaveOccurred())
+
+		transaction := actual.Transactions[0]
+		Expect(transaction.Index).To(Equal(0))
+		Expect(transaction.Type).To(Equal(cb.HeaderType_ENDORSER_TRANSACTION))
+		Expect(transaction.ChannelID).To(Equal("mychannel"))
+		Expect(transaction.TxID).To(Equal("TX_ID"))
+		Expect(transaction.Timestamp).To(Equal(timestamp))
+		Expect(transaction.ChannelHeader.GetTxId()).To(Equal("TX_ID"))
+	})
+
+	It("Transaction creator", func() {
+		actual, err := block.Decode(NewBlock(7, endorserTransaction))
+		Expect(err).NotTo(HaveOccurred())
+
+		creator := actual.Transactions[0].Creator
+		Expect(creator.MSPID).To(Equal("Org1MSP"))
+		Expect(creator.Credentials).To(Equal(ReadAdminCertificate("org1.example.com")))
+		Expect(creator.Certificate).NotTo(BeNil())
+		Expect(creator.Certificate.Subject.CommonName).To(Equal("Admin@org1.example.com"))
+	})
+
+	It("Validation codes from transactions filter", func() {
+		input := NewBlock(7, endorserTransaction, NewEndorserTransaction("TX_ID_2", timestamp))
+		input.Metadata.Metadata[cb.BlockMetadataIndex_TRANSACTIONS_FILTER] = []byte{
+			byte(pb.TxValidationCode_VALID),
+			byte(pb.TxValidationCode_MVCC_READ_CONFLICT),
+		}
+
+		actual, err := block.Decode(input)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(actual.Transactions).To(HaveLen(2))
+		Expect(actual.Transactions[0].ValidationCode).To(Equal(pb.TxValidationCode_VALID))
+		Expect(actual.Transactions[1].Index).To(Equal(1))
+		Expect(actual.Transactions[1].TxID).To(Equal("TX_ID_2"))
+		Expect(actual.Transactions[1].ValidationCode).To(Equal(pb.TxValidationCode_MVCC_READ_CONFLICT))
+	})
+
+	It("Unvalidated block without transactions filter", func() {
+		input := NewBlock(7, endorserTransaction)
+		input.Metadata = nil
+
+		actual, err := block.Decode(input)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(actual.Transactions[0].ValidationCode).To(Equal(pb.TxValidationCode_NOT_VALIDATED))
+	})
+
+	It("Chaincode invocation", func() {
+		actual, err := block.Decode(NewBlock(7, endorserTransaction))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(actual.Transactions[0].Actions).To(HaveLen(1))
+		action := actual.Transactions[0].Actions[0]
+
+		Expect(action.Chaincode).To(Equal(&block.ChaincodeInvocation{
+			Name: "basic",
+			Args: [][]byte{[]byte("CreateAsset"), []byte("asset1")},
+		}))
+		Expect(action.ChaincodeID.GetName()).To(Equal("basic"))
+		Expect(action.ChaincodeID.GetVersion()).To(Equal("1.0"))
+		Expect(action.Response.GetStatus()).To(Equal(int32(200)))
+		Expect(action.Response.GetPayload()).To(Equal([]byte("RESULT")))
+		Expect(action.Event.GetEventName()).To(Equal("AssetCreated"))
+		Expect(action.Event.GetPayload()).To(Equal([]byte("EVENT")))
+	})
+
+	It("Read/write sets", func() {
+		actual, err := block.Decode(NewBlock(7, endorserTransaction))
+		Expect(err).NotTo(HaveOccurred())
+
+		readWriteSets := actual.Transactions[0].Actions[0].ReadWriteSets
+		Expect(readWriteSets).To(HaveLen(1))
+		Expect(readWriteSets[0].Namespace).To(Equal("basic"))
+
+		writes := readWriteSets[0].ReadWriteSet.GetWrites()
+		Expect(writes).To(HaveLen(1))
+		Expect(writes[0].GetKey()).To(Equal("asset1"))
+		Expect(writes[0].GetValue()).To(Equal([]byte("VALUE")))
+
+		collections := readWriteSets[0].CollectionHashedReadWriteSets
+		Expect(collections).To(HaveLen(1))
+		Expect(collections[0].CollectionName).To(Equal("private"))
+		Expect(collections[0].PrivateReadWriteSetHash).To(Equal([]byte("PRIVATE_HASH")))
+		Expect(collections[0].HashedReadWriteSet.GetHashedWrites()[0].GetKeyHash()).To(Equal([]byte("KEY_HASH")))
+	})
+
+	It("Endorsers", func() {
+		actual, err := block.Decode(NewBlock(7, endorserTransaction))
+		Expect(err).NotTo(HaveOccurred())
+
+		endorsers := actual.Transactions[0].Actions[0].Endorsers
+		Expect(endorsers).To(HaveLen(2))
+		Expect(endorsers[0].MSPID).To(Equal("Org1MSP"))
+		Expect(endorsers[1].MSPID).To(Equal("Org2MSP"))
+		Expect(endorsers[1].Certificate.Subject.CommonName).To(Equal("Admin@org2.example.com"))
+	})
+
+	It("Serialized block", func() {
+		input := NewBlock(7, endorserTransaction)
+
+		actual, err := block.DecodeBytes(MarshalProto(input))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(actual.Number).To(Equal(uint64(7)))
+		Expect(actual.Transactions[0].TxID).To(Equal("TX_ID"))
+	})
+
+	It("Block without header", func() {
+		input := NewBlock(7, endorserTransaction)
+		input.Header = nil
+
+		_, err := block.Decode(input)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("Invalid envelope", func() {
+		input := NewBlock(7)
+		input.Data.Data = [][]byte{[]byte("INVALID")}
+
+		_, err := block.Decode(input)
+		Expect(err).To(MatchError(ContainSubstring("transaction 0")))
+	})
+})
+
+var _ = Describe("DecodeTransaction", func() {
+	It("Config update transaction", func() {
+		envelope := &cb.Envelope{}
+		err := proto.Unmarshal(ReadTestFile("..", "org3_update_in_envelope.pb"), envelope)
+		Expect(err).NotTo(HaveOccurred())
+
+		actual, err := block.DecodeTransaction(envelope)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(actual.Type).To(Equal(cb.HeaderType_CONFIG_UPDATE))
+		Expect(actual.ValidationCode).To(Equal(pb.TxValidationCode_NOT_VALIDATED))
+		Expect(actual.Actions).To(BeEmpty())
+	})
+
+	It("Transaction without creator", func() {
+		envelope := &cb.Envelope{
+			Payload: MarshalProto(&cb.Payload{
+				Header: &cb.Header{
+					ChannelHeader: MarshalProto(&cb.ChannelHeader{
+						Type:      int32(cb.HeaderType_CONFIG),
+						ChannelId: "mychannel",
+					}),
+					SignatureHeader: MarshalProto(&cb.SignatureHeader{}),
+				},
+			}),
+		}
+
+		actual, err := block.DecodeTransaction(envelope)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(actual.Type).To(Equal(cb.HeaderType_CONFIG))
+		Expect(actual.ChannelID).To(Equal("mychannel"))
+		Expect(actual.Creator).To(BeNil())
+		Expect(actual.Timestamp.IsZero()).To(BeTrue())
+	})
+})
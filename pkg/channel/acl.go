@@ -0,0 +1,177 @@
+package channel
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"strings"
+
+	icc "github.com/hyperledger/fabric-admin-sdk/internal/channelconfig"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	pb "github.com/hyperledger/fabric-protos-go-apiv2/peer"
+)
+
+const (
+	applicationReadersPolicy = "/Channel/Application/Readers"
+	applicationWritersPolicy = "/Channel/Application/Writers"
+)
+
+var defaultACLs = map[string]string{
+	"_lifecycle/CheckCommitReadiness":      applicationWritersPolicy,
+	"_lifecycle/CommitChaincodeDefinition": applicationWritersPolicy,
+	"_lifecycle/QueryChaincodeDefinition":  applicationWritersPolicy,
+	"_lifecycle/QueryChaincodeDefinitions": applicationWritersPolicy,
+	"lscc/ChaincodeExists":                 applicationReadersPolicy,
+	"lscc/GetDeploymentSpec":               applicationReadersPolicy,
+	"lscc/GetChaincodeData":                applicationReadersPolicy,
+	"lscc/GetInstantiatedChaincodes":       applicationReadersPolicy,
+	"qscc/GetChainInfo":                    applicationReadersPolicy,
+	"qscc/GetBlockByNumber":                applicationReadersPolicy,
+	"qscc/GetBlockByHash":                  applicationReadersPolicy,
+	"qscc/GetTransactionByID":              applicationReadersPolicy,
+	"qscc/GetBlockByTxID":                  applicationReadersPolicy,
+	"cscc/GetConfigBlock":                  applicationReadersPolicy,
+	"cscc/GetChannelConfig":                applicationReadersPolicy,
+	"peer/Propose":                         applicationWritersPolicy,
+	"peer/ChaincodeToChaincode":            applicationWritersPolicy,
+	"event/Block":                          applicationReadersPolicy,
+	"event/FilteredBlock":                  applicationReadersPolicy,
+}
+
+// DefaultACLs returns the default mapping of API resources to policies, as defined in the sample configtx.yaml
+// provided with Fabric.
+func DefaultACLs() map[string]string {
+	return maps.Clone(defaultACLs)
+}
+
+// ListACLs returns the mapping of API resources to policies defined in the application group of a channel.
+func ListACLs(config *cb.Config) (map[string]string, error) {
+	return (&ChannelConfig{config: config}).ACLs()
+}
+
+// SetACLs creates an unsigned config update that sets the policies for the specified API resources, for example
+// "qscc/GetBlockByNumber". ACLs for other resources are unchanged. Every policy reference must identify a policy that
+// exists in the channel configuration, either as an absolute path such as "/Channel/Application/Readers" or as the
+// name of an application group policy.
+func SetACLs(channelID string, current *cb.Config, acls map[string]string) (*ConfigUpdate, error) {
+	if len(acls) == 0 {
+		return nil, errors.New("no ACLs specified")
+	}
+
+	return updateACLs(channelID, current, func(existing map[string]string) (map[string]string, error) {
+		maps.Copy(existing, acls)
+		return existing, nil
+	})
+}
+
+// SetACL creates an unsigned config update that sets the policy for a single API resource.
+func SetACL(channelID string, current *cb.Config, resource string, policyRef string) (*ConfigUpdate, error) {
+	return SetACLs(channelID, current, map[string]string{resource: policyRef})
+}
+
+// RemoveACL creates an unsigned config update that removes the ACL for an API resource, after which the peer's
+// default policy for that resource applies.
+func RemoveACL(channelID string, current *cb.Config, resource string) (*ConfigUpdate, error) {
+	return updateACLs(channelID, current, func(existing map[string]string) (map[string]string, error) {
+		if _, ok := existing[resource]; !ok {
+			return nil, fmt.Errorf("no ACL defined for resource %s", resource)
+		}
+
+		delete(existing, resource)
+		return existing, nil
+	})
+}
+
+// ResetACLs creates an unsigned config update that replaces all ACLs with the defaults returned by DefaultACLs.
+func ResetACLs(channelID string, current *cb.Config) (*ConfigUpdate, error) {
+	return updateACLs(channelID, current, func(map[string]string) (map[string]string, error) {
+		return DefaultACLs(), nil
+	})
+}
+
+func updateACLs(
+	channelID string,
+	current *cb.Config,
+	modify func(existing map[string]string) (map[string]string, error),
+) (*ConfigUpdate, error) {
+	return newConfigUpdate(channelID, current, func(config *cb.Config) error {
+		existing, err := ListACLs(config)
+		if err != nil {
+			return err
+		}
+
+		updated, err := modify(existing)
+		if err != nil {
+			return err
+		}
+
+		for resource, policyRef := range updated {
+			if err := checkPolicyExists(config, policyRef); err != nil {
+				return fmt.Errorf("invalid ACL for resource %s: %w", resource, err)
+			}
+		}
+
+		applicationGroup, err := findGroup(config.GetChannelGroup(), ApplicationGroupKey)
+		if err != nil {
+			return err
+		}
+
+		if len(updated) == 0 {
+			delete(applicationGroup.Values, ACLsKey)
+			return nil
+		}
+
+		setValue(applicationGroup, icc.ACLValues(updated), AdminsPolicyKey)
+		return nil
+	})
+}
+
+// checkPolicyExists ensures that a policy reference identifies a policy in the channel configuration. Absolute
+// references are a path from the channel group, such as "/Channel/Application/Readers". Relative references are the
+// name of a policy in the application group.
+func checkPolicyExists(config *cb.Config, policyRef string) error {
+	groupPath, policyName, err := splitPolicyRef(policyRef)
+	if err != nil {
+		return err
+	}
+
+	group, err := findGroup(config.GetChannelGroup(), groupPath...)
+	if err != nil {
+		return fmt.Errorf("policy %s does not exist: %w", policyRef, err)
+	}
+
+	if _, ok := group.GetPolicies()[policyName]; !ok {
+		return fmt.Errorf("policy %s does not exist", policyRef)
+	}
+
+	return nil
+}
+
+// splitPolicyRef splits a policy reference into the path of its config group below the channel group, and the policy
+// name. Relative references are resolved against the application group.
+func splitPolicyRef(policyRef string) ([]string, string, error) {
+	if !strings.HasPrefix(policyRef, "/") {
+		if policyRef == "" || strings.Contains(policyRef, "/") {
+			return nil, "", fmt.Errorf("invalid policy reference: %q", policyRef)
+		}
+		return []string{ApplicationGroupKey}, policyRef, nil
+	}
+
+	elements := strings.Split(strings.TrimPrefix(policyRef, "/"), "/")
+	if len(elements) < 2 || elements[0] != ChannelGroupKey || elements[len(elements)-1] == "" {
+		return nil, "", fmt.Errorf("invalid policy reference: %q", policyRef)
+	}
+
+	return elements[1 : len(elements)-1], elements[len(elements)-1], nil
+}
+
+// aclPolicies returns the policy references of an ACL value, keyed by API resource.
+func aclPolicies(acls *pb.ACLs) map[string]string {
+	result := make(map[string]string, len(acls.GetAcls()))
+	for resource, acl := range acls.GetAcls() {
+		result[resource] = acl.GetPolicyRef()
+	}
+
+	return result
+}
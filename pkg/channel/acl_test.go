@@ -0,0 +1,101 @@
+package channel_test
+
+import (
+	"github.com/hyperledger/fabric-admin-sdk/pkg/channel"
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	pb "github.com/hyperledger/fabric-protos-go-apiv2/peer"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+)
+
+func ACLsFromUpdate(configUpdate *channel.ConfigUpdate) map[string]string {
+	applicationGroup := AssertConfigUpdate(configUpdate).GetWriteSet().GetGroups()[channel.ApplicationGroupKey]
+	value, ok := applicationGroup.GetValues()[channel.ACLsKey]
+	if !ok {
+		return nil
+	}
+
+	acls := &pb.ACLs{}
+	Expect(proto.Unmarshal(value.GetValue(), acls)).To(Succeed())
+
+	result := make(map[string]string)
+	for resource, acl := range acls.GetAcls() {
+		result[resource] = acl.GetPolicyRef()
+	}
+	return result
+}
+
+func UpdatedApplicationConfig(config *cb.Config, configUpdate *channel.ConfigUpdate) *cb.Config {
+	writeGroup := AssertConfigUpdate(configUpdate).GetWriteSet().GetGroups()[channel.ApplicationGroupKey]
+	updated := proto.Clone(config).(*cb.Config)
+	applicationGroup := updated.GetChannelGroup().GetGroups()[channel.ApplicationGroupKey]
+	for key, value := range writeGroup.GetValues() {
+		applicationGroup.Values[key] = value
+	}
+	return updated
+}
+
+var _ = Describe("ACLs", func() {
+	var config *cb.Config
+
+	BeforeEach(func() {
+		genesisConfig := NewTestGenesisConfig()
+		genesisConfig.Application.ACLs = channel.DefaultACLs()
+
+		block, err := channel.NewGenesisBlock("mychannel", genesisConfig)
+		Expect(err).NotTo(HaveOccurred())
+
+		config, err = channel.ConfigFromBlock(block)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Lists ACLs", func() {
+		acls, err := channel.ListACLs(config)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(acls).To(Equal(channel.DefaultACLs()))
+	})
+
+	It("Sets individual ACL", func() {
+		configUpdate, err := channel.SetACL("mychannel", config, "qscc/GetBlockByNumber", "/Channel/Application/Org1MSP/Admins")
+		Expect(err).NotTo(HaveOccurred())
+
+		acls := ACLsFromUpdate(configUpdate)
+		Expect(acls).To(HaveKeyWithValue("qscc/GetBlockByNumber", "/Channel/Application/Org1MSP/Admins"))
+		Expect(acls).To(HaveKeyWithValue("_lifecycle/CommitChaincodeDefinition", "/Channel/Application/Writers"))
+	})
+
+	It("Accepts relative policy reference", func() {
+		_, err := channel.SetACL("mychannel", config, "qscc/GetBlockByNumber", "Admins")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	DescribeTable("Rejects policy references that do not exist",
+		func(policyRef string) {
+			_, err := channel.SetACL("mychannel", config, "qscc/GetBlockByNumber", policyRef)
+			Expect(err).To(MatchError(ContainSubstring("qscc/GetBlockByNumber")))
+		},
+		Entry("Misspelled policy", "/Channel/Application/Reader"),
+		Entry("Unknown group", "/Channel/Aplication/Readers"),
+		Entry("Unknown organization", "/Channel/Application/Org3MSP/Admins"),
+		Entry("Not below channel group", "/Application/Readers"),
+		Entry("Unknown relative policy", "Reader"),
+		Entry("Empty reference", ""),
+	)
+
+	It("Removes ACL", func() {
+		configUpdate, err := channel.RemoveACL("mychannel", config, "qscc/GetBlockByNumber")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ACLsFromUpdate(configUpdate)).NotTo(HaveKey("qscc/GetBlockByNumber"))
+	})
+
+	It("Resets ACLs to defaults", func() {
+		configUpdate, err := channel.SetACL("mychannel", config, "qscc/GetBlockByNumber", "/Channel/Application/Writers")
+		Expect(err).NotTo(HaveOccurred())
+		config = UpdatedApplicationConfig(config, configUpdate)
+
+		configUpdate, err = channel.ResetACLs("mychannel", config)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ACLsFromUpdate(configUpdate)).To(Equal(channel.DefaultACLs()))
+	})
+})
@@ -0,0 +1,143 @@
+package channel
+
+import (
+	"errors"
+	"fmt"
+
+	icc "github.com/hyperledger/fabric-admin-sdk/internal/channelconfig"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	pb "github.com/hyperledger/fabric-protos-go-apiv2/peer"
+)
+
+// ListAnchorPeers returns the anchor peers of the named application organization.
+func ListAnchorPeers(config *cb.Config, orgName string) ([]*AnchorPeer, error) {
+	orgGroup, err := findGroup(config.GetChannelGroup(), ApplicationGroupKey, orgName)
+	if err != nil {
+		return nil, err
+	}
+
+	return anchorPeers(orgGroup)
+}
+
+// AddAnchorPeer creates an unsigned config update that adds an anchor peer to the named application organization.
+// The update modifies only the organization's own config group, so requires only the signature of an organization
+// admin.
+func AddAnchorPeer(channelID string, current *cb.Config, orgName string, anchorPeer *AnchorPeer) (*ConfigUpdate, error) {
+	if err := validateAnchorPeer(anchorPeer); err != nil {
+		return nil, err
+	}
+
+	return updateAnchorPeers(channelID, current, orgName, func(existing []*AnchorPeer) ([]*AnchorPeer, error) {
+		if containsAnchorPeer(existing, anchorPeer) {
+			return nil, fmt.Errorf("anchor peer %s:%d already exists for organization %s", anchorPeer.Host, anchorPeer.Port, orgName)
+		}
+
+		return append(existing, anchorPeer), nil
+	})
+}
+
+// RemoveAnchorPeer creates an unsigned config update that removes an anchor peer from the named application
+// organization. The update modifies only the organization's own config group, so requires only the signature of an
+// organization admin.
+func RemoveAnchorPeer(channelID string, current *cb.Config, orgName string, anchorPeer *AnchorPeer) (*ConfigUpdate, error) {
+	if err := validateAnchorPeer(anchorPeer); err != nil {
+		return nil, err
+	}
+
+	return updateAnchorPeers(channelID, current, orgName, func(existing []*AnchorPeer) ([]*AnchorPeer, error) {
+		var result []*AnchorPeer
+		for _, peer := range existing {
+			if *peer != *anchorPeer {
+				result = append(result, peer)
+			}
+		}
+
+		if len(result) == len(existing) {
+			return nil, fmt.Errorf("anchor peer %s:%d does not exist for organization %s", anchorPeer.Host, anchorPeer.Port, orgName)
+		}
+
+		return result, nil
+	})
+}
+
+// SetAnchorPeers creates an unsigned config update that replaces all the anchor peers of the named application
+// organization. The update modifies only the organization's own config group, so requires only the signature of an
+// organization admin.
+func SetAnchorPeers(channelID string, current *cb.Config, orgName string, anchorPeers []*AnchorPeer) (*ConfigUpdate, error) {
+	for i, anchorPeer := range anchorPeers {
+		if err := validateAnchorPeer(anchorPeer); err != nil {
+			return nil, err
+		}
+		if containsAnchorPeer(anchorPeers[:i], anchorPeer) {
+			return nil, fmt.Errorf("duplicate anchor peer %s:%d for organization %s", anchorPeer.Host, anchorPeer.Port, orgName)
+		}
+	}
+
+	return updateAnchorPeers(channelID, current, orgName, func([]*AnchorPeer) ([]*AnchorPeer, error) {
+		return anchorPeers, nil
+	})
+}
+
+func validateAnchorPeer(anchorPeer *AnchorPeer) error {
+	if anchorPeer == nil {
+		return errors.New("anchor peer is required")
+	}
+
+	if anchorPeer.Host == "" || anchorPeer.Port == 0 {
+		return fmt.Errorf("anchor peer host and port are required: %s:%d", anchorPeer.Host, anchorPeer.Port)
+	}
+
+	return nil
+}
+
+func containsAnchorPeer(anchorPeers []*AnchorPeer, anchorPeer *AnchorPeer) bool {
+	for _, peer := range anchorPeers {
+		if *peer == *anchorPeer {
+			return true
+		}
+	}
+
+	return false
+}
+
+func updateAnchorPeers(
+	channelID string,
+	current *cb.Config,
+	orgName string,
+	modify func(existing []*AnchorPeer) ([]*AnchorPeer, error),
+) (*ConfigUpdate, error) {
+	return newConfigUpdate(channelID, current, func(config *cb.Config) error {
+		orgGroup, err := findGroup(config.GetChannelGroup(), ApplicationGroupKey, orgName)
+		if err != nil {
+			return err
+		}
+
+		existing, err := anchorPeers(orgGroup)
+		if err != nil {
+			return err
+		}
+
+		updated, err := modify(existing)
+		if err != nil {
+			return err
+		}
+
+		if len(updated) == 0 {
+			delete(orgGroup.Values, AnchorPeersKey)
+			return nil
+		}
+
+		var anchorPeerProtos []*pb.AnchorPeer
+		for _, anchorPeer := range updated {
+			anchorPeerProtos = append(anchorPeerProtos, &pb.AnchorPeer{
+				Host: anchorPeer.Host,
+				Port: anchorPeer.Port,
+			})
+		}
+
+		setValue(orgGroup, icc.AnchorPeersValue(anchorPeerProtos), AdminsPolicyKey)
+
+		return nil
+	})
+}
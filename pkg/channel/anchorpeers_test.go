@@ -0,0 +1,119 @@
+package channel_test
+
+import (
+	"github.com/hyperledger/fabric-admin-sdk/pkg/channel"
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	pb "github.com/hyperledger/fabric-protos-go-apiv2/peer"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+)
+
+func AnchorPeersFromUpdate(update *cb.ConfigUpdate, orgName string) []*pb.AnchorPeer {
+	orgGroup := update.GetWriteSet().GetGroups()[channel.ApplicationGroupKey].GetGroups()[orgName]
+	Expect(orgGroup).NotTo(BeNil())
+
+	result := &pb.AnchorPeers{}
+	Expect(proto.Unmarshal(orgGroup.GetValues()[channel.AnchorPeersKey].GetValue(), result)).To(Succeed())
+	return result.GetAnchorPeers()
+}
+
+var _ = Describe("Anchor peers", func() {
+	var config *cb.Config
+
+	BeforeEach(func() {
+		config = NewTestConfig()
+	})
+
+	It("Lists anchor peers", func() {
+		anchorPeers, err := channel.ListAnchorPeers(config, "Org1MSP")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(anchorPeers).To(ConsistOf(&channel.AnchorPeer{Host: "peer0.org1.example.com", Port: 7051}))
+	})
+
+	It("Adds anchor peer to organization without anchor peers", func() {
+		configUpdate, err := channel.AddAnchorPeer("mychannel", config, "Org2MSP", &channel.AnchorPeer{Host: "peer0.org2.example.com", Port: 9051})
+		Expect(err).NotTo(HaveOccurred())
+
+		update := AssertConfigUpdate(configUpdate)
+		anchorPeers := AnchorPeersFromUpdate(update, "Org2MSP")
+		Expect(anchorPeers).To(HaveLen(1))
+		Expect(anchorPeers[0].GetHost()).To(Equal("peer0.org2.example.com"))
+
+		applicationGroup := update.GetWriteSet().GetGroups()[channel.ApplicationGroupKey]
+		Expect(applicationGroup.GetVersion()).To(BeZero(), "application group should be unmodified")
+		Expect(applicationGroup.GetGroups()["Org2MSP"].GetModPolicy()).To(Equal(channel.AdminsPolicyKey))
+	})
+
+	It("Adds anchor peer to existing anchor peers", func() {
+		configUpdate, err := channel.AddAnchorPeer("mychannel", config, "Org1MSP", &channel.AnchorPeer{Host: "peer1.org1.example.com", Port: 8051})
+		Expect(err).NotTo(HaveOccurred())
+
+		update := AssertConfigUpdate(configUpdate)
+		Expect(AnchorPeersFromUpdate(update, "Org1MSP")).To(HaveLen(2))
+
+		orgGroup := update.GetWriteSet().GetGroups()[channel.ApplicationGroupKey].GetGroups()["Org1MSP"]
+		Expect(orgGroup.GetVersion()).To(BeZero(), "organization group should be unmodified")
+		Expect(orgGroup.GetValues()[channel.AnchorPeersKey].GetVersion()).To(BeEquivalentTo(1))
+	})
+
+	It("Fails to add duplicate anchor peer", func() {
+		_, err := channel.AddAnchorPeer("mychannel", config, "Org1MSP", &channel.AnchorPeer{Host: "peer0.org1.example.com", Port: 7051})
+		Expect(err).To(MatchError(ContainSubstring("already exists")))
+	})
+
+	It("Removes anchor peer", func() {
+		configUpdate, err := channel.RemoveAnchorPeer("mychannel", config, "Org1MSP", &channel.AnchorPeer{Host: "peer0.org1.example.com", Port: 7051})
+		Expect(err).NotTo(HaveOccurred())
+
+		update := AssertConfigUpdate(configUpdate)
+		orgGroup := update.GetWriteSet().GetGroups()[channel.ApplicationGroupKey].GetGroups()["Org1MSP"]
+		Expect(orgGroup.GetValues()).NotTo(HaveKey(channel.AnchorPeersKey))
+	})
+
+	It("Fails to remove anchor peer that does not exist", func() {
+		_, err := channel.RemoveAnchorPeer("mychannel", config, "Org1MSP", &channel.AnchorPeer{Host: "peer1.org1.example.com", Port: 7051})
+		Expect(err).To(MatchError(ContainSubstring("does not exist")))
+	})
+
+	It("Fails to remove nil anchor peer", func() {
+		_, err := channel.RemoveAnchorPeer("mychannel", config, "Org1MSP", nil)
+		Expect(err).To(MatchError(ContainSubstring("anchor peer is required")))
+	})
+
+	It("Replaces anchor peers", func() {
+		configUpdate, err := channel.SetAnchorPeers("mychannel", config, "Org1MSP", []*channel.AnchorPeer{
+			{Host: "peer1.org1.example.com", Port: 8051},
+			{Host: "peer2.org1.example.com", Port: 9051},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		anchorPeers := AnchorPeersFromUpdate(AssertConfigUpdate(configUpdate), "Org1MSP")
+		Expect(anchorPeers).To(HaveLen(2))
+		Expect(anchorPeers[0].GetHost()).To(Equal("peer1.org1.example.com"))
+	})
+
+	It("Fails to replace anchor peers with missing host or port", func() {
+		_, err := channel.SetAnchorPeers("mychannel", config, "Org1MSP", []*channel.AnchorPeer{
+			{Host: "peer1.org1.example.com", Port: 8051},
+			{Host: "", Port: 9051},
+		})
+		Expect(err).To(MatchError(ContainSubstring("host and port are required")))
+
+		_, err = channel.SetAnchorPeers("mychannel", config, "Org1MSP", []*channel.AnchorPeer{
+			{Host: "peer1.org1.example.com", Port: 0},
+		})
+		Expect(err).To(MatchError(ContainSubstring("host and port are required")))
+
+		_, err = channel.SetAnchorPeers("mychannel", config, "Org1MSP", []*channel.AnchorPeer{nil})
+		Expect(err).To(MatchError(ContainSubstring("anchor peer is required")))
+	})
+
+	It("Fails to replace anchor peers with duplicates", func() {
+		_, err := channel.SetAnchorPeers("mychannel", config, "Org1MSP", []*channel.AnchorPeer{
+			{Host: "peer1.org1.example.com", Port: 8051},
+			{Host: "peer1.org1.example.com", Port: 8051},
+		})
+		Expect(err).To(MatchError(ContainSubstring("duplicate anchor peer peer1.org1.example.com:8051")))
+	})
+})
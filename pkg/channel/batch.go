@@ -0,0 +1,104 @@
+package channel
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	icc "github.com/hyperledger/fabric-admin-sdk/internal/channelconfig"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+)
+
+// DefaultOrdererMaxMessageSize is the default maximum size of messages received by an orderer, as configured by
+// General.MaxRecvMsgSize in the orderer configuration.
+const DefaultOrdererMaxMessageSize = 100 * 1024 * 1024
+
+// BatchSettings controls how the ordering service batches transactions into blocks.
+type BatchSettings struct {
+	// BatchSize to apply. The existing batch size is unchanged if not specified.
+	BatchSize *BatchSize
+
+	// BatchTimeout to apply, as a duration string such as "2s". The existing batch timeout is unchanged if not
+	// specified.
+	BatchTimeout string
+
+	// MaxMessageSize is the maximum message size accepted by the orderers, which limits AbsoluteMaxBytes. Defaults to
+	// DefaultOrdererMaxMessageSize.
+	MaxMessageSize uint32
+}
+
+// UpdateBatchSettings creates an unsigned config update that changes the orderer batch size and batch timeout of a
+// channel. The changed values are governed by the /Channel/Orderer/Admins policy, so the update must be signed by
+// orderer organization admins.
+func UpdateBatchSettings(channelID string, current *cb.Config, settings *BatchSettings) (*ConfigUpdate, error) {
+	if settings.BatchSize == nil && settings.BatchTimeout == "" {
+		return nil, errors.New("no batch settings specified")
+	}
+
+	if settings.BatchSize != nil {
+		maxMessageSize := valueOrDefault(settings.MaxMessageSize, DefaultOrdererMaxMessageSize)
+		if err := settings.BatchSize.validate(maxMessageSize); err != nil {
+			return nil, err
+		}
+	}
+
+	if settings.BatchTimeout != "" {
+		if err := validateBatchTimeout(settings.BatchTimeout); err != nil {
+			return nil, err
+		}
+	}
+
+	return newConfigUpdate(channelID, current, func(config *cb.Config) error {
+		ordererGroup, err := findGroup(config.GetChannelGroup(), OrdererGroupKey)
+		if err != nil {
+			return err
+		}
+
+		if settings.BatchSize != nil {
+			setValue(ordererGroup, icc.BatchSizeValue(
+				settings.BatchSize.MaxMessageCount,
+				settings.BatchSize.AbsoluteMaxBytes,
+				settings.BatchSize.PreferredMaxBytes,
+			), AdminsPolicyKey)
+		}
+
+		if settings.BatchTimeout != "" {
+			setValue(ordererGroup, icc.BatchTimeoutValue(settings.BatchTimeout), AdminsPolicyKey)
+		}
+
+		return nil
+	})
+}
+
+func (b *BatchSize) validate(maxMessageSize uint32) error {
+	if b.MaxMessageCount == 0 {
+		return errors.New("batch size MaxMessageCount must be greater than zero")
+	}
+	if b.AbsoluteMaxBytes == 0 {
+		return errors.New("batch size AbsoluteMaxBytes must be greater than zero")
+	}
+	if b.PreferredMaxBytes == 0 {
+		return errors.New("batch size PreferredMaxBytes must be greater than zero")
+	}
+	if b.PreferredMaxBytes > b.AbsoluteMaxBytes {
+		return fmt.Errorf("batch size PreferredMaxBytes (%d) must not exceed AbsoluteMaxBytes (%d)", b.PreferredMaxBytes, b.AbsoluteMaxBytes)
+	}
+	if b.AbsoluteMaxBytes > maxMessageSize {
+		return fmt.Errorf("batch size AbsoluteMaxBytes (%d) must not exceed orderer maximum message size (%d)", b.AbsoluteMaxBytes, maxMessageSize)
+	}
+
+	return nil
+}
+
+func validateBatchTimeout(batchTimeout string) error {
+	timeout, err := time.ParseDuration(batchTimeout)
+	if err != nil {
+		return fmt.Errorf("invalid batch timeout: %w", err)
+	}
+	if timeout <= 0 {
+		return fmt.Errorf("batch timeout must be greater than zero: %s", batchTimeout)
+	}
+
+	return nil
+}
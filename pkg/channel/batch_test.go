@@ -0,0 +1,69 @@
+package channel_test
+
+import (
+	"time"
+
+	"github.com/hyperledger/fabric-admin-sdk/pkg/channel"
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Batch settings", func() {
+	var config *cb.Config
+
+	BeforeEach(func() {
+		config = NewTestConfig()
+	})
+
+	It("Updates batch size and timeout", func() {
+		batchSize := &channel.BatchSize{
+			MaxMessageCount:   100,
+			AbsoluteMaxBytes:  4 * 1024 * 1024,
+			PreferredMaxBytes: 1024 * 1024,
+		}
+		configUpdate, err := channel.UpdateBatchSettings("mychannel", config, &channel.BatchSettings{
+			BatchSize:    batchSize,
+			BatchTimeout: "500ms",
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		updated := UpdatedConfig(config, configUpdate)
+		Expect(updated.BatchSize()).To(Equal(batchSize))
+		Expect(updated.BatchTimeout()).To(Equal(500 * time.Millisecond))
+
+		ordererGroup := AssertConfigUpdate(configUpdate).GetWriteSet().GetGroups()[channel.OrdererGroupKey]
+		Expect(ordererGroup.GetValues()[channel.BatchSizeKey].GetModPolicy()).To(Equal(channel.AdminsPolicyKey))
+	})
+
+	It("Updates only batch timeout", func() {
+		configUpdate, err := channel.UpdateBatchSettings("mychannel", config, &channel.BatchSettings{
+			BatchTimeout: "1s",
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		ordererGroup := AssertConfigUpdate(configUpdate).GetWriteSet().GetGroups()[channel.OrdererGroupKey]
+		Expect(ordererGroup.GetValues()[channel.BatchTimeoutKey].GetVersion()).To(BeEquivalentTo(1))
+		Expect(ordererGroup.GetValues()[channel.BatchSizeKey]).To(BeNil())
+	})
+
+	DescribeTable("Rejects invalid settings",
+		func(settings *channel.BatchSettings) {
+			_, err := channel.UpdateBatchSettings("mychannel", config, settings)
+			Expect(err).To(HaveOccurred())
+		},
+		Entry("No settings", &channel.BatchSettings{}),
+		Entry("PreferredMaxBytes exceeds AbsoluteMaxBytes", &channel.BatchSettings{
+			BatchSize: &channel.BatchSize{MaxMessageCount: 10, AbsoluteMaxBytes: 1024, PreferredMaxBytes: 2048},
+		}),
+		Entry("AbsoluteMaxBytes exceeds orderer limit", &channel.BatchSettings{
+			BatchSize:      &channel.BatchSize{MaxMessageCount: 10, AbsoluteMaxBytes: 2048, PreferredMaxBytes: 1024},
+			MaxMessageSize: 1024,
+		}),
+		Entry("Zero MaxMessageCount", &channel.BatchSettings{
+			BatchSize: &channel.BatchSize{AbsoluteMaxBytes: 2048, PreferredMaxBytes: 1024},
+		}),
+		Entry("Invalid timeout", &channel.BatchSettings{BatchTimeout: "2 seconds"}),
+		Entry("Zero timeout", &channel.BatchSettings{BatchTimeout: "0s"}),
+	)
+})
@@ -3,6 +3,7 @@ package channel
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/hyperledger/fabric-admin-sdk/pkg/identity"
 	"github.com/hyperledger/fabric-admin-sdk/pkg/internal/proposal"
@@ -42,8 +43,56 @@ func GetBlockChainInfo(ctx context.Context, connection grpc.ClientConnInterface,
 	return blockChainInfo, nil
 }
 
-func getSignedProposal(ctx context.Context, connection grpc.ClientConnInterface, channelID, ccName, funcName string, id identity.SigningIdentity) (*pb.ProposalResponse, error) {
-	prop, err := proposal.NewProposal(id, ccName, funcName, proposal.WithChannel(channelID), proposal.WithArguments([]byte(channelID)))
+// GetBlockByNumber gets the block with the specified number from the ledger of a peer using the qscc system chaincode.
+func GetBlockByNumber(ctx context.Context, connection grpc.ClientConnInterface, id identity.SigningIdentity, channelID string, number uint64) (*cb.Block, error) {
+	return queryBlock(ctx, connection, id, channelID, "GetBlockByNumber", []byte(strconv.FormatUint(number, 10)))
+}
+
+// GetBlockByHash gets the block with the specified header hash from the ledger of a peer using the qscc system
+// chaincode.
+func GetBlockByHash(ctx context.Context, connection grpc.ClientConnInterface, id identity.SigningIdentity, channelID string, hash []byte) (*cb.Block, error) {
+	return queryBlock(ctx, connection, id, channelID, "GetBlockByHash", hash)
+}
+
+// GetBlockByTxID gets the block containing the specified transaction from the ledger of a peer using the qscc system
+// chaincode.
+func GetBlockByTxID(ctx context.Context, connection grpc.ClientConnInterface, id identity.SigningIdentity, channelID string, txID string) (*cb.Block, error) {
+	return queryBlock(ctx, connection, id, channelID, "GetBlockByTxID", []byte(txID))
+}
+
+// GetTransactionByID gets the specified transaction, along with its validation code, from the ledger of a peer using
+// the qscc system chaincode.
+func GetTransactionByID(ctx context.Context, connection grpc.ClientConnInterface, id identity.SigningIdentity, channelID string, txID string) (*pb.ProcessedTransaction, error) {
+	proposalResp, err := getSignedProposal(ctx, connection, channelID, "qscc", "GetTransactionByID", id, []byte(txID))
+	if err != nil {
+		return nil, fmt.Errorf("get signed proposal %w", err)
+	}
+
+	transaction := &pb.ProcessedTransaction{}
+	if err := proto.Unmarshal(proposalResp.GetResponse().GetPayload(), transaction); err != nil {
+		return nil, fmt.Errorf("transaction unmarshal %w", err)
+	}
+	return transaction, nil
+}
+
+func queryBlock(ctx context.Context, connection grpc.ClientConnInterface, id identity.SigningIdentity, channelID string, funcName string, arg []byte) (*cb.Block, error) {
+	proposalResp, err := getSignedProposal(ctx, connection, channelID, "qscc", funcName, id, arg)
+	if err != nil {
+		return nil, fmt.Errorf("get signed proposal %w", err)
+	}
+
+	block := &cb.Block{}
+	if err := proto.Unmarshal(proposalResp.GetResponse().GetPayload(), block); err != nil {
+		return nil, fmt.Errorf("block unmarshal %w", err)
+	}
+	return block, nil
+}
+
+// getSignedProposal invokes a system chaincode function on a peer. The channel ID is always passed as the first
+// argument, followed by any additional arguments.
+func getSignedProposal(ctx context.Context, connection grpc.ClientConnInterface, channelID, ccName, funcName string, id identity.SigningIdentity, args ...[]byte) (*pb.ProposalResponse, error) {
+	args = append([][]byte{[]byte(channelID)}, args...)
+	prop, err := proposal.NewProposal(id, ccName, funcName, proposal.WithChannel(channelID), proposal.WithArguments(args...))
 	if err != nil {
 		return nil, err
 	}
@@ -59,5 +108,9 @@ func getSignedProposal(ctx context.Context, connection grpc.ClientConnInterface,
 		return nil, fmt.Errorf("process proposal %w", err)
 	}
 
+	if err := proposal.CheckSuccessfulResponse(proposalResp); err != nil {
+		return nil, err
+	}
+
 	return proposalResp, nil
 }
@@ -0,0 +1,160 @@
+package channel_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hyperledger/fabric-admin-sdk/pkg/channel"
+	"github.com/hyperledger/fabric-admin-sdk/pkg/identity"
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	pb "github.com/hyperledger/fabric-protos-go-apiv2/peer"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+//go:generate mockgen -destination ./clientconnection_mock_test.go -package ${GOPACKAGE} google.golang.org/grpc ClientConnInterface
+
+const processProposalMethod = "/protos.Endorser/ProcessProposal"
+
+func NewProposalResponse(status cb.Status, message string, payload []byte) *pb.ProposalResponse {
+	return &pb.ProposalResponse{
+		Response: &pb.Response{
+			Status:  int32(status),
+			Message: message,
+			Payload: payload,
+		},
+	}
+}
+
+// NewMockEndorser returns a connection that responds to proposals with the supplied response, and records the
+// chaincode invocation of each proposal received.
+func NewMockEndorser(controller *gomock.Controller, response *pb.ProposalResponse, invocations *[]*pb.ChaincodeInvocationSpec) *MockClientConnInterface {
+	connection := NewMockClientConnInterface(controller)
+	connection.EXPECT().
+		Invoke(gomock.Any(), gomock.Eq(processProposalMethod), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, method string, in *pb.SignedProposal, out *pb.ProposalResponse, opts ...grpc.CallOption) error {
+			*invocations = append(*invocations, InvocationFromSignedProposal(in))
+			proto.Merge(out, response)
+			return nil
+		}).
+		AnyTimes()
+
+	return connection
+}
+
+func InvocationFromSignedProposal(signedProposal *pb.SignedProposal) *pb.ChaincodeInvocationSpec {
+	proposal := &pb.Proposal{}
+	Expect(proto.Unmarshal(signedProposal.GetProposalBytes(), proposal)).To(Succeed())
+
+	payload := &pb.ChaincodeProposalPayload{}
+	Expect(proto.Unmarshal(proposal.GetPayload(), payload)).To(Succeed())
+
+	result := &pb.ChaincodeInvocationSpec{}
+	Expect(proto.Unmarshal(payload.GetInput(), result)).To(Succeed())
+
+	return result
+}
+
+var _ = Describe("qscc", func() {
+	var id identity.SigningIdentity
+	var expectedBlock *cb.Block
+	var invocations []*pb.ChaincodeInvocationSpec
+
+	BeforeEach(func() {
+		id = NewTestOrgAdmin("org1.example.com", "Org1MSP")
+		expectedBlock = &cb.Block{
+			Header: &cb.BlockHeader{
+				Number:   7,
+				DataHash: []byte("DATA_HASH"),
+			},
+		}
+		invocations = nil
+	})
+
+	AssertInvocation := func(function string, args ...string) {
+		Expect(invocations).To(HaveLen(1))
+		spec := invocations[0].GetChaincodeSpec()
+		Expect(spec.GetChaincodeId().GetName()).To(Equal("qscc"))
+
+		expected := [][]byte{[]byte(function), []byte("mychannel")}
+		for _, arg := range args {
+			expected = append(expected, []byte(arg))
+		}
+		Expect(spec.GetInput().GetArgs()).To(Equal(expected))
+	}
+
+	It("GetBlockByNumber", func(specCtx SpecContext) {
+		controller := gomock.NewController(GinkgoT())
+		connection := NewMockEndorser(controller, NewProposalResponse(cb.Status_SUCCESS, "", AssertMarshal(expectedBlock)), &invocations)
+
+		actual, err := channel.GetBlockByNumber(specCtx, connection, id, "mychannel", 7)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(proto.Equal(actual, expectedBlock)).To(BeTrue())
+		AssertInvocation("GetBlockByNumber", "7")
+	})
+
+	It("GetBlockByHash", func(specCtx SpecContext) {
+		controller := gomock.NewController(GinkgoT())
+		connection := NewMockEndorser(controller, NewProposalResponse(cb.Status_SUCCESS, "", AssertMarshal(expectedBlock)), &invocations)
+
+		actual, err := channel.GetBlockByHash(specCtx, connection, id, "mychannel", []byte("BLOCK_HASH"))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(proto.Equal(actual, expectedBlock)).To(BeTrue())
+		AssertInvocation("GetBlockByHash", "BLOCK_HASH")
+	})
+
+	It("GetBlockByTxID", func(specCtx SpecContext) {
+		controller := gomock.NewController(GinkgoT())
+		connection := NewMockEndorser(controller, NewProposalResponse(cb.Status_SUCCESS, "", AssertMarshal(expectedBlock)), &invocations)
+
+		actual, err := channel.GetBlockByTxID(specCtx, connection, id, "mychannel", "TX_ID")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(proto.Equal(actual, expectedBlock)).To(BeTrue())
+		AssertInvocation("GetBlockByTxID", "TX_ID")
+	})
+
+	It("GetTransactionByID", func(specCtx SpecContext) {
+		expected := &pb.ProcessedTransaction{
+			TransactionEnvelope: &cb.Envelope{Payload: []byte("PAYLOAD")},
+			ValidationCode:      int32(pb.TxValidationCode_MVCC_READ_CONFLICT),
+		}
+
+		controller := gomock.NewController(GinkgoT())
+		connection := NewMockEndorser(controller, NewProposalResponse(cb.Status_SUCCESS, "", AssertMarshal(expected)), &invocations)
+
+		actual, err := channel.GetTransactionByID(specCtx, connection, id, "mychannel", "TX_ID")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(proto.Equal(actual, expected)).To(BeTrue())
+		AssertInvocation("GetTransactionByID", "TX_ID")
+	})
+
+	It("Unsuccessful proposal response gives error", func(specCtx SpecContext) {
+		controller := gomock.NewController(GinkgoT())
+		connection := NewMockEndorser(controller, NewProposalResponse(cb.Status_INTERNAL_SERVER_ERROR, "EXPECTED_ERROR", nil), &invocations)
+
+		_, err := channel.GetTransactionByID(specCtx, connection, id, "mychannel", "TX_ID")
+
+		Expect(err).To(MatchError(ContainSubstring("EXPECTED_ERROR")))
+	})
+
+	It("Endorser client errors returned", func(specCtx SpecContext) {
+		expectedErr := errors.New("EXPECTED_ERROR")
+
+		controller := gomock.NewController(GinkgoT())
+		connection := NewMockClientConnInterface(controller)
+		connection.EXPECT().
+			Invoke(gomock.Any(), gomock.Eq(processProposalMethod), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(expectedErr)
+
+		_, err := channel.GetBlockByNumber(specCtx, connection, id, "mychannel", 7)
+
+		Expect(err).To(MatchError(expectedErr))
+	})
+})
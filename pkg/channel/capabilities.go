@@ -0,0 +1,173 @@
+package channel
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	icc "github.com/hyperledger/fabric-admin-sdk/internal/channelconfig"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+)
+
+// Capability levels within channel configuration.
+const (
+	ChannelCapabilityLevel     = ChannelGroupKey
+	OrdererCapabilityLevel     = OrdererGroupKey
+	ApplicationCapabilityLevel = ApplicationGroupKey
+)
+
+var capabilityPattern = regexp.MustCompile(`^V\d+(_\d+)*$`)
+
+// Capabilities are the target capabilities for each level of channel configuration, for example V3_0, V2_0 and V2_5.
+// Empty levels are left unchanged.
+type Capabilities struct {
+	Channel     string
+	Orderer     string
+	Application string
+}
+
+// CapabilityUpgrade is the result of setting target capabilities for a channel.
+type CapabilityUpgrade struct {
+	// ConfigUpdate that sets the target capabilities, or nil if all levels already have their target capabilities.
+	ConfigUpdate *ConfigUpdate
+
+	// Changed capability levels, such as ChannelCapabilityLevel.
+	Changed []string
+
+	// Warnings about capabilities that cannot take effect until a lower level is upgraded.
+	Warnings []string
+}
+
+// UpgradeCapabilities creates a single unsigned config update that sets the target capability at each level of the
+// channel configuration, replacing any existing capabilities at that level.
+func UpgradeCapabilities(channelID string, current *cb.Config, target *Capabilities) (*CapabilityUpgrade, error) {
+	for _, capability := range []string{target.Channel, target.Orderer, target.Application} {
+		if capability != "" && !capabilityPattern.MatchString(capability) {
+			return nil, fmt.Errorf("invalid capability: %s", capability)
+		}
+	}
+
+	channelConfig := &ChannelConfig{config: current}
+	levels := []*capabilityLevel{
+		{ChannelCapabilityLevel, target.Channel, nil},
+		{OrdererCapabilityLevel, target.Orderer, []string{OrdererGroupKey}},
+		{ApplicationCapabilityLevel, target.Application, []string{ApplicationGroupKey}},
+	}
+
+	result := &CapabilityUpgrade{}
+	effective := make(map[string][]string)
+	var changed []*capabilityLevel
+
+	for _, level := range levels {
+		existing, err := channelConfig.capabilities(level.groupPath...)
+		if err != nil {
+			return nil, err
+		}
+
+		effective[level.name] = existing
+		if level.target == "" || slices.Equal(existing, []string{level.target}) {
+			continue
+		}
+
+		effective[level.name] = []string{level.target}
+		changed = append(changed, level)
+		result.Changed = append(result.Changed, level.name)
+	}
+
+	result.Warnings = capabilityWarnings(effective)
+
+	if len(changed) == 0 {
+		return result, nil
+	}
+
+	configUpdate, err := newConfigUpdate(channelID, current, setCapabilities(changed))
+	if err != nil {
+		return nil, err
+	}
+
+	result.ConfigUpdate = configUpdate
+	return result, nil
+}
+
+// capabilityLevel is a level of channel configuration at which capabilities are set.
+type capabilityLevel struct {
+	name      string
+	target    string
+	groupPath []string
+}
+
+func setCapabilities(levels []*capabilityLevel) ConfigModifier {
+	return func(config *cb.Config) error {
+		for _, level := range levels {
+			group, err := findGroup(config.GetChannelGroup(), level.groupPath...)
+			if err != nil {
+				return err
+			}
+
+			setValue(group, icc.CapabilitiesValue(map[string]bool{level.target: true}), AdminsPolicyKey)
+		}
+
+		return nil
+	}
+}
+
+// capabilityWarnings reports orderer and application capabilities that require a newer major release than the
+// channel capability, since they cannot take effect until the channel capability is also upgraded.
+func capabilityWarnings(capabilities map[string][]string) []string {
+	channelVersion := highestCapability(capabilities[ChannelCapabilityLevel])
+
+	var result []string
+	for _, level := range []string{OrdererCapabilityLevel, ApplicationCapabilityLevel} {
+		version := highestCapability(capabilities[level])
+		if len(version) == 0 {
+			continue
+		}
+
+		if len(channelVersion) == 0 || channelVersion[0] < version[0] {
+			result = append(result, fmt.Sprintf(
+				"%s capability %s cannot take effect until the channel capability is upgraded to at least V%d_0",
+				level, capabilityString(version), version[0],
+			))
+		}
+	}
+
+	return result
+}
+
+func highestCapability(capabilities []string) []int {
+	var result []int
+	for _, capability := range capabilities {
+		version := parseCapability(capability)
+		if slices.Compare(version, result) > 0 {
+			result = version
+		}
+	}
+
+	return result
+}
+
+func parseCapability(capability string) []int {
+	if !capabilityPattern.MatchString(capability) {
+		return nil
+	}
+
+	var result []int
+	for _, part := range strings.Split(strings.TrimPrefix(capability, "V"), "_") {
+		n, _ := strconv.Atoi(part)
+		result = append(result, n)
+	}
+
+	return result
+}
+
+func capabilityString(version []int) string {
+	parts := make([]string, len(version))
+	for i, n := range version {
+		parts[i] = strconv.Itoa(n)
+	}
+
+	return "V" + strings.Join(parts, "_")
+}
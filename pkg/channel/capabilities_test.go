@@ -0,0 +1,81 @@
+package channel_test
+
+import (
+	"github.com/hyperledger/fabric-admin-sdk/pkg/channel"
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+)
+
+func CapabilitiesFromGroup(group *cb.ConfigGroup) []string {
+	capabilities := &cb.Capabilities{}
+	Expect(proto.Unmarshal(group.GetValues()[channel.CapabilitiesKey].GetValue(), capabilities)).To(Succeed())
+
+	var result []string
+	for name := range capabilities.GetCapabilities() {
+		result = append(result, name)
+	}
+	return result
+}
+
+var _ = Describe("Capabilities", func() {
+	var config *cb.Config
+
+	BeforeEach(func() {
+		genesisConfig := NewTestGenesisConfig()
+		genesisConfig.Capabilities = []string{"V2_0"}
+		genesisConfig.Application.Capabilities = []string{"V2_0"}
+
+		block, err := channel.NewGenesisBlock("mychannel", genesisConfig)
+		Expect(err).NotTo(HaveOccurred())
+
+		config, err = channel.ConfigFromBlock(block)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Upgrades only levels that change", func() {
+		upgrade, err := channel.UpgradeCapabilities("mychannel", config, &channel.Capabilities{
+			Channel:     "V3_0",
+			Orderer:     "V2_0",
+			Application: "V2_5",
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(upgrade.Changed).To(Equal([]string{channel.ChannelCapabilityLevel, channel.ApplicationCapabilityLevel}))
+		Expect(upgrade.Warnings).To(BeEmpty())
+
+		writeSet := AssertConfigUpdate(upgrade.ConfigUpdate).GetWriteSet()
+		Expect(CapabilitiesFromGroup(writeSet)).To(ConsistOf("V3_0"))
+		Expect(CapabilitiesFromGroup(writeSet.GetGroups()[channel.ApplicationGroupKey])).To(ConsistOf("V2_5"))
+		Expect(writeSet.GetGroups()[channel.OrdererGroupKey].GetValues()).NotTo(HaveKey(channel.CapabilitiesKey))
+	})
+
+	It("Returns no config update when nothing changes", func() {
+		upgrade, err := channel.UpgradeCapabilities("mychannel", config, &channel.Capabilities{
+			Channel: "V2_0",
+			Orderer: "V2_0",
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(upgrade.Changed).To(BeEmpty())
+		Expect(upgrade.ConfigUpdate).To(BeNil())
+	})
+
+	It("Warns when channel capability is too low", func() {
+		upgrade, err := channel.UpgradeCapabilities("mychannel", config, &channel.Capabilities{
+			Orderer: "V3_0",
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(upgrade.Changed).To(Equal([]string{channel.OrdererCapabilityLevel}))
+		Expect(upgrade.Warnings).To(ConsistOf(ContainSubstring("Orderer capability V3_0")))
+	})
+
+	It("Rejects invalid capability", func() {
+		_, err := channel.UpgradeCapabilities("mychannel", config, &channel.Capabilities{
+			Channel: "3.0",
+		})
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -39,27 +39,8 @@ func JoinChannel(ctx context.Context, connection grpc.ClientConnInterface, id id
 		return fmt.Errorf("failed to marshal block: %w", err)
 	}
 
-	prop, err := proposal.NewProposal(id, "cscc", "JoinChain", proposal.WithArguments(blockBytes), proposal.WithType(cb.HeaderType_CONFIG))
-	if err != nil {
-		return err
-	}
-
-	signedProp, err := proposal.NewSignedProposal(prop, id)
-	if err != nil {
-		return err
-	}
-
-	endorser := peer.NewEndorserClient(connection)
-	proposalResp, err := endorser.ProcessProposal(ctx, signedProp)
-	if err != nil {
-		return err
-	}
-
-	if err := proposal.CheckSuccessfulResponse(proposalResp); err != nil {
-		return err
-	}
-
-	return nil
+	_, err = invokeCSCC(ctx, connection, id, "JoinChain", cb.HeaderType_CONFIG, blockBytes)
+	return err
 }
 
 func ListChannel(osnURL string, caCertPool *x509.CertPool, tlsClientCert tls.Certificate) (ChannelList, error) {
@@ -0,0 +1,542 @@
+package channel
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	icc "github.com/hyperledger/fabric-admin-sdk/internal/channelconfig"
+	"github.com/hyperledger/fabric-admin-sdk/internal/configtxgen/encoder"
+	imsp "github.com/hyperledger/fabric-admin-sdk/internal/msp"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"github.com/hyperledger/fabric-protos-go-apiv2/msp"
+	ab "github.com/hyperledger/fabric-protos-go-apiv2/orderer"
+	"github.com/hyperledger/fabric-protos-go-apiv2/orderer/etcdraft"
+	"github.com/hyperledger/fabric-protos-go-apiv2/orderer/smartbft"
+	pb "github.com/hyperledger/fabric-protos-go-apiv2/peer"
+	"google.golang.org/protobuf/proto"
+)
+
+// Keys of groups, values and policies within channel configuration.
+const (
+	ChannelGroupKey     = "Channel"
+	OrdererGroupKey     = icc.OrdererGroupKey
+	ApplicationGroupKey = icc.ApplicationGroupKey
+
+	AdminsPolicyKey               = icc.AdminsPolicyKey
+	ReadersPolicyKey              = icc.ReadersPolicyKey
+	WritersPolicyKey              = icc.WritersPolicyKey
+	EndorsementPolicyKey          = "Endorsement"
+	LifecycleEndorsementPolicyKey = "LifecycleEndorsement"
+	BlockValidationPolicyKey      = encoder.BlockValidationPolicyKey
+
+	HashingAlgorithmKey          = icc.HashingAlgorithmKey
+	BlockDataHashingStructureKey = icc.BlockDataHashingStructureKey
+	OrdererAddressesKey          = icc.OrdererAddressesKey
+	CapabilitiesKey              = icc.CapabilitiesKey
+	BatchSizeKey                 = icc.BatchSizeKey
+	BatchTimeoutKey              = icc.BatchTimeoutKey
+	ChannelRestrictionsKey       = icc.ChannelRestrictionsKey
+	ConsensusTypeKey             = icc.ConsensusTypeKey
+	MSPKey                       = icc.MSPKey
+	EndpointsKey                 = icc.EndpointsKey
+	ACLsKey                      = icc.ACLsKey
+	AnchorPeersKey               = icc.AnchorPeersKey
+	OrderersKey                  = icc.OrderersKey
+)
+
+// ChannelConfig provides a read-only view of a channel configuration, with typed accessors for commonly used
+// configuration elements.
+type ChannelConfig struct {
+	config *cb.Config
+}
+
+// OrganizationConfig is the configuration of an organization within a channel.
+type OrganizationConfig struct {
+	// Name of the organization's config group.
+	Name string
+
+	// MSPID of the organization's Membership Service Provider.
+	MSPID string
+
+	// MSP definition of the organization.
+	MSP *msp.MSPConfig
+
+	// AnchorPeers of an application organization.
+	AnchorPeers []*AnchorPeer
+
+	// OrdererEndpoints of an orderer organization.
+	OrdererEndpoints []string
+
+	// Policies defined for the organization.
+	Policies map[string]*ConfigPolicy
+}
+
+// ConfigPolicy is a policy defined within channel configuration, along with the policy that governs its modification.
+type ConfigPolicy struct {
+	Policy    *cb.Policy
+	ModPolicy string
+}
+
+// NewChannelConfig creates a read-only view of a channel configuration. The configuration is copied so subsequent
+// changes to the supplied configuration are not reflected in the view.
+func NewChannelConfig(config *cb.Config) (*ChannelConfig, error) {
+	if config.GetChannelGroup() == nil {
+		return nil, errors.New("config contains no channel group")
+	}
+
+	return &ChannelConfig{
+		config: proto.Clone(config).(*cb.Config),
+	}, nil
+}
+
+// ChannelConfigFromBlock creates a read-only view of the channel configuration contained in a config block, such as
+// the block returned by GetConfigBlock or GetConfigBlockFromOrderer.
+func ChannelConfigFromBlock(block *cb.Block) (*ChannelConfig, error) {
+	config, err := ConfigFromBlock(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewChannelConfig(config)
+}
+
+// Config returns a copy of the underlying channel configuration.
+func (c *ChannelConfig) Config() *cb.Config {
+	return proto.Clone(c.config).(*cb.Config)
+}
+
+// Sequence number of the channel configuration.
+func (c *ChannelConfig) Sequence() uint64 {
+	return c.config.GetSequence()
+}
+
+// ApplicationOrganizations returns the organizations in the application group, ordered by name.
+func (c *ChannelConfig) ApplicationOrganizations() ([]*OrganizationConfig, error) {
+	group, err := c.group(ApplicationGroupKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return organizationConfigs(group)
+}
+
+// ApplicationOrganization returns the named organization in the application group.
+func (c *ChannelConfig) ApplicationOrganization(name string) (*OrganizationConfig, error) {
+	group, err := c.group(ApplicationGroupKey, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return newOrganizationConfig(name, group)
+}
+
+// OrdererOrganizations returns the organizations in the orderer group, ordered by name.
+func (c *ChannelConfig) OrdererOrganizations() ([]*OrganizationConfig, error) {
+	group, err := c.group(OrdererGroupKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return organizationConfigs(group)
+}
+
+// AnchorPeers returns the anchor peers of the named application organization.
+func (c *ChannelConfig) AnchorPeers(orgName string) ([]*AnchorPeer, error) {
+	group, err := c.group(ApplicationGroupKey, orgName)
+	if err != nil {
+		return nil, err
+	}
+
+	return anchorPeers(group)
+}
+
+// OrdererEndpoints returns the orderer endpoints of each orderer organization, keyed by organization name.
+func (c *ChannelConfig) OrdererEndpoints() (map[string][]string, error) {
+	orgs, err := c.OrdererOrganizations()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string][]string, len(orgs))
+	for _, org := range orgs {
+		result[org.Name] = org.OrdererEndpoints
+	}
+
+	return result, nil
+}
+
+// OrdererType returns the consensus type of the ordering service, such as ConsensusTypeEtcdRaft or ConsensusTypeBFT.
+func (c *ChannelConfig) OrdererType() (string, error) {
+	consensusType, err := c.consensusType()
+	if err != nil {
+		return "", err
+	}
+
+	return consensusType.GetType(), nil
+}
+
+// ConsensusState returns the state of the ordering service, which is either normal or maintenance.
+func (c *ChannelConfig) ConsensusState() (ab.ConsensusType_State, error) {
+	consensusType, err := c.consensusType()
+	if err != nil {
+		return 0, err
+	}
+
+	return consensusType.GetState(), nil
+}
+
+// EtcdRaftMetadata returns the etcdraft consenters and options. It fails if the orderer type is not etcdraft.
+func (c *ChannelConfig) EtcdRaftMetadata() (*etcdraft.ConfigMetadata, error) {
+	consensusType, err := c.consensusType()
+	if err != nil {
+		return nil, err
+	}
+	if consensusType.GetType() != ConsensusTypeEtcdRaft {
+		return nil, fmt.Errorf("orderer type is %s, not %s", consensusType.GetType(), ConsensusTypeEtcdRaft)
+	}
+
+	result := &etcdraft.ConfigMetadata{}
+	if err := proto.Unmarshal(consensusType.GetMetadata(), result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal etcdraft metadata: %w", err)
+	}
+
+	return result, nil
+}
+
+// SmartBFTOptions returns the BFT consensus options. It fails if the orderer type is not BFT.
+func (c *ChannelConfig) SmartBFTOptions() (*smartbft.Options, error) {
+	consensusType, err := c.consensusType()
+	if err != nil {
+		return nil, err
+	}
+	if consensusType.GetType() != ConsensusTypeBFT {
+		return nil, fmt.Errorf("orderer type is %s, not %s", consensusType.GetType(), ConsensusTypeBFT)
+	}
+
+	result := &smartbft.Options{}
+	if err := proto.Unmarshal(consensusType.GetMetadata(), result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal BFT options: %w", err)
+	}
+
+	return result, nil
+}
+
+// Consenters returns the ordering service nodes participating in consensus, for either etcdraft or BFT consensus.
+// The ID, MSPID and Identity fields are populated only for BFT consensus.
+func (c *ChannelConfig) Consenters() ([]*Consenter, error) {
+	ordererType, err := c.OrdererType()
+	if err != nil {
+		return nil, err
+	}
+
+	switch ordererType {
+	case ConsensusTypeEtcdRaft:
+		metadata, err := c.EtcdRaftMetadata()
+		if err != nil {
+			return nil, err
+		}
+
+		var result []*Consenter
+		for _, consenter := range metadata.GetConsenters() {
+			result = append(result, &Consenter{
+				Host:          consenter.GetHost(),
+				Port:          consenter.GetPort(),
+				ClientTLSCert: consenter.GetClientTlsCert(),
+				ServerTLSCert: consenter.GetServerTlsCert(),
+			})
+		}
+
+		return result, nil
+
+	case ConsensusTypeBFT:
+		orderers, err := c.bftOrderers()
+		if err != nil {
+			return nil, err
+		}
+
+		var result []*Consenter
+		for _, consenter := range orderers.GetConsenterMapping() {
+			result = append(result, &Consenter{
+				ID:            consenter.GetId(),
+				Host:          consenter.GetHost(),
+				Port:          consenter.GetPort(),
+				MSPID:         consenter.GetMspId(),
+				Identity:      consenter.GetIdentity(),
+				ClientTLSCert: consenter.GetClientTlsCert(),
+				ServerTLSCert: consenter.GetServerTlsCert(),
+			})
+		}
+
+		return result, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported orderer type: %s", ordererType)
+	}
+}
+
+// BatchSize returns the orderer batch size settings.
+func (c *ChannelConfig) BatchSize() (*BatchSize, error) {
+	batchSize := &ab.BatchSize{}
+	if err := c.unmarshalValue(batchSize, BatchSizeKey, OrdererGroupKey); err != nil {
+		return nil, err
+	}
+
+	return &BatchSize{
+		MaxMessageCount:   batchSize.GetMaxMessageCount(),
+		AbsoluteMaxBytes:  batchSize.GetAbsoluteMaxBytes(),
+		PreferredMaxBytes: batchSize.GetPreferredMaxBytes(),
+	}, nil
+}
+
+// BatchTimeout returns the amount of time the orderer waits before creating a batch.
+func (c *ChannelConfig) BatchTimeout() (time.Duration, error) {
+	batchTimeout := &ab.BatchTimeout{}
+	if err := c.unmarshalValue(batchTimeout, BatchTimeoutKey, OrdererGroupKey); err != nil {
+		return 0, err
+	}
+
+	result, err := time.ParseDuration(batchTimeout.GetTimeout())
+	if err != nil {
+		return 0, fmt.Errorf("invalid batch timeout: %w", err)
+	}
+
+	return result, nil
+}
+
+// ChannelCapabilities returns the capabilities enabled at the channel level, in sorted order.
+func (c *ChannelConfig) ChannelCapabilities() ([]string, error) {
+	return c.capabilities()
+}
+
+// OrdererCapabilities returns the capabilities enabled at the orderer level, in sorted order.
+func (c *ChannelConfig) OrdererCapabilities() ([]string, error) {
+	return c.capabilities(OrdererGroupKey)
+}
+
+// ApplicationCapabilities returns the capabilities enabled at the application level, in sorted order.
+func (c *ChannelConfig) ApplicationCapabilities() ([]string, error) {
+	return c.capabilities(ApplicationGroupKey)
+}
+
+// ACLs returns the mapping of API resources to policies defined in the application group. Resources without an
+// explicit ACL use the peer's default policies, and are not included.
+func (c *ChannelConfig) ACLs() (map[string]string, error) {
+	acls := &pb.ACLs{}
+	if err := c.unmarshalValue(acls, ACLsKey, ApplicationGroupKey); err != nil {
+		if errors.Is(err, errNotFound) {
+			return map[string]string{}, nil
+		}
+		return nil, err
+	}
+
+	return aclPolicies(acls), nil
+}
+
+// Policies returns the policies defined in the config group at the specified path below the channel group. For
+// example, no path elements returns the channel policies, and the path elements "Application", "Org1MSP" return the
+// policies of the Org1MSP application organization.
+func (c *ChannelConfig) Policies(groupPath ...string) (map[string]*ConfigPolicy, error) {
+	group, err := c.group(groupPath...)
+	if err != nil {
+		return nil, err
+	}
+
+	return configPolicies(group), nil
+}
+
+// ModPolicy returns the modification policy of the config group at the specified path below the channel group.
+func (c *ChannelConfig) ModPolicy(groupPath ...string) (string, error) {
+	group, err := c.group(groupPath...)
+	if err != nil {
+		return "", err
+	}
+
+	return group.GetModPolicy(), nil
+}
+
+func (c *ChannelConfig) consensusType() (*ab.ConsensusType, error) {
+	result := &ab.ConsensusType{}
+	if err := c.unmarshalValue(result, ConsensusTypeKey, OrdererGroupKey); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (c *ChannelConfig) bftOrderers() (*cb.Orderers, error) {
+	result := &cb.Orderers{}
+	if err := c.unmarshalValue(result, OrderersKey, OrdererGroupKey); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (c *ChannelConfig) capabilities(groupPath ...string) ([]string, error) {
+	capabilities := &cb.Capabilities{}
+	if err := c.unmarshalValue(capabilities, CapabilitiesKey, groupPath...); err != nil {
+		if errors.Is(err, errNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return capabilityNames(capabilities), nil
+}
+
+func (c *ChannelConfig) group(groupPath ...string) (*cb.ConfigGroup, error) {
+	return findGroup(c.config.GetChannelGroup(), groupPath...)
+}
+
+func (c *ChannelConfig) unmarshalValue(message proto.Message, key string, groupPath ...string) error {
+	group, err := c.group(groupPath...)
+	if err != nil {
+		return err
+	}
+
+	return unmarshalValue(group, key, message)
+}
+
+var errNotFound = errors.New("not found")
+
+// findGroup returns the config group at the specified path below the supplied group.
+func findGroup(group *cb.ConfigGroup, groupPath ...string) (*cb.ConfigGroup, error) {
+	for i, name := range groupPath {
+		child, ok := group.GetGroups()[name]
+		if !ok {
+			return nil, fmt.Errorf("config group %s %w", groupPathString(groupPath[:i+1]), errNotFound)
+		}
+		group = child
+	}
+
+	return group, nil
+}
+
+func groupPathString(groupPath []string) string {
+	result := "/" + ChannelGroupKey
+	for _, name := range groupPath {
+		result += "/" + name
+	}
+
+	return result
+}
+
+func unmarshalValue(group *cb.ConfigGroup, key string, message proto.Message) error {
+	value, ok := group.GetValues()[key]
+	if !ok {
+		return fmt.Errorf("config value %s %w", key, errNotFound)
+	}
+
+	if err := proto.Unmarshal(value.GetValue(), message); err != nil {
+		return fmt.Errorf("failed to unmarshal %s config value: %w", key, err)
+	}
+
+	return nil
+}
+
+func organizationConfigs(parent *cb.ConfigGroup) ([]*OrganizationConfig, error) {
+	var result []*OrganizationConfig
+	for _, name := range sortedKeys(parent.GetGroups()) {
+		org, err := newOrganizationConfig(name, parent.GetGroups()[name])
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, org)
+	}
+
+	return result, nil
+}
+
+func newOrganizationConfig(name string, group *cb.ConfigGroup) (*OrganizationConfig, error) {
+	mspConfig := &msp.MSPConfig{}
+	if err := unmarshalValue(group, MSPKey, mspConfig); err != nil {
+		return nil, fmt.Errorf("organization %s: %w", name, err)
+	}
+
+	mspID, err := mspIDFromConfig(mspConfig)
+	if err != nil {
+		return nil, fmt.Errorf("organization %s: %w", name, err)
+	}
+
+	anchorPeers, err := anchorPeers(group)
+	if err != nil {
+		return nil, fmt.Errorf("organization %s: %w", name, err)
+	}
+
+	endpoints := &cb.OrdererAddresses{}
+	if err := unmarshalValue(group, EndpointsKey, endpoints); err != nil && !errors.Is(err, errNotFound) {
+		return nil, fmt.Errorf("organization %s: %w", name, err)
+	}
+
+	return &OrganizationConfig{
+		Name:             name,
+		MSPID:            mspID,
+		MSP:              mspConfig,
+		AnchorPeers:      anchorPeers,
+		OrdererEndpoints: endpoints.GetAddresses(),
+		Policies:         configPolicies(group),
+	}, nil
+}
+
+func mspIDFromConfig(mspConfig *msp.MSPConfig) (string, error) {
+	switch imsp.ProviderType(mspConfig.GetType()) {
+	case imsp.FABRIC:
+		fabricConfig := &msp.FabricMSPConfig{}
+		if err := proto.Unmarshal(mspConfig.GetConfig(), fabricConfig); err != nil {
+			return "", fmt.Errorf("failed to unmarshal MSP config: %w", err)
+		}
+		return fabricConfig.GetName(), nil
+
+	case imsp.IDEMIX:
+		idemixConfig := &msp.IdemixMSPConfig{}
+		if err := proto.Unmarshal(mspConfig.GetConfig(), idemixConfig); err != nil {
+			return "", fmt.Errorf("failed to unmarshal idemix MSP config: %w", err)
+		}
+		return idemixConfig.GetName(), nil
+
+	default:
+		return "", fmt.Errorf("unsupported MSP type: %d", mspConfig.GetType())
+	}
+}
+
+func anchorPeers(group *cb.ConfigGroup) ([]*AnchorPeer, error) {
+	anchorPeers := &pb.AnchorPeers{}
+	if err := unmarshalValue(group, AnchorPeersKey, anchorPeers); err != nil && !errors.Is(err, errNotFound) {
+		return nil, err
+	}
+
+	var result []*AnchorPeer
+	for _, anchorPeer := range anchorPeers.GetAnchorPeers() {
+		result = append(result, &AnchorPeer{
+			Host: anchorPeer.GetHost(),
+			Port: anchorPeer.GetPort(),
+		})
+	}
+
+	return result, nil
+}
+
+func configPolicies(group *cb.ConfigGroup) map[string]*ConfigPolicy {
+	result := make(map[string]*ConfigPolicy, len(group.GetPolicies()))
+	for name, policy := range group.GetPolicies() {
+		result[name] = &ConfigPolicy{
+			Policy:    policy.GetPolicy(),
+			ModPolicy: policy.GetModPolicy(),
+		}
+	}
+
+	return result
+}
+
+func capabilityNames(capabilities *cb.Capabilities) []string {
+	return sortedKeys(capabilities.GetCapabilities())
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	return slices.Sorted(maps.Keys(m))
+}
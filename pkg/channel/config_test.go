@@ -0,0 +1,133 @@
+package channel_test
+
+import (
+	"time"
+
+	"github.com/hyperledger/fabric-admin-sdk/pkg/channel"
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	ab "github.com/hyperledger/fabric-protos-go-apiv2/orderer"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func NewTestChannelConfig() *channel.ChannelConfig {
+	channelConfig, err := channel.ChannelConfigFromBlock(NewTestGenesisBlock("mychannel"))
+	Expect(err).NotTo(HaveOccurred())
+	return channelConfig
+}
+
+var _ = Describe("ChannelConfig", func() {
+	var channelConfig *channel.ChannelConfig
+
+	BeforeEach(func() {
+		channelConfig = NewTestChannelConfig()
+	})
+
+	It("Lists application organizations", func() {
+		orgs, err := channelConfig.ApplicationOrganizations()
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(orgs).To(HaveLen(2))
+		Expect(orgs[0].Name).To(Equal("Org1MSP"))
+		Expect(orgs[0].MSPID).To(Equal("Org1MSP"))
+		Expect(orgs[0].MSP).NotTo(BeNil())
+		Expect(orgs[0].Policies).To(HaveKey(channel.EndorsementPolicyKey))
+		Expect(orgs[1].MSPID).To(Equal("Org2MSP"))
+	})
+
+	It("Reads anchor peers", func() {
+		anchorPeers, err := channelConfig.AnchorPeers("Org1MSP")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(anchorPeers).To(ConsistOf(&channel.AnchorPeer{Host: "peer0.org1.example.com", Port: 7051}))
+
+		anchorPeers, err = channelConfig.AnchorPeers("Org2MSP")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(anchorPeers).To(BeEmpty())
+	})
+
+	It("Fails to read anchor peers for unknown organization", func() {
+		_, err := channelConfig.AnchorPeers("Org3MSP")
+		Expect(err).To(MatchError(ContainSubstring("/Channel/Application/Org3MSP")))
+	})
+
+	It("Reads orderer endpoints", func() {
+		endpoints, err := channelConfig.OrdererEndpoints()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(endpoints).To(Equal(map[string][]string{"OrdererOrg": {"orderer.example.com:7050"}}))
+	})
+
+	It("Reads orderer type and consenters", func() {
+		ordererType, err := channelConfig.OrdererType()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ordererType).To(Equal(channel.ConsensusTypeEtcdRaft))
+
+		state, err := channelConfig.ConsensusState()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(state).To(Equal(ab.ConsensusType_STATE_NORMAL))
+
+		consenters, err := channelConfig.Consenters()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(consenters).To(HaveLen(1))
+		Expect(consenters[0].Host).To(Equal("orderer.example.com"))
+		Expect(consenters[0].Port).To(BeEquivalentTo(7050))
+
+		_, err = channelConfig.SmartBFTOptions()
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("Reads batch settings", func() {
+		batchSize, err := channelConfig.BatchSize()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(batchSize).To(Equal(&channel.BatchSize{
+			MaxMessageCount:   500,
+			AbsoluteMaxBytes:  10 * 1024 * 1024,
+			PreferredMaxBytes: 2 * 1024 * 1024,
+		}))
+
+		batchTimeout, err := channelConfig.BatchTimeout()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(batchTimeout).To(Equal(2 * time.Second))
+	})
+
+	It("Reads capabilities at each level", func() {
+		Expect(channelConfig.ChannelCapabilities()).To(Equal([]string{"V3_0"}))
+		Expect(channelConfig.OrdererCapabilities()).To(Equal([]string{"V2_0"}))
+		Expect(channelConfig.ApplicationCapabilities()).To(Equal([]string{"V2_5"}))
+	})
+
+	It("Reads ACLs", func() {
+		genesisConfig := NewTestGenesisConfig()
+		genesisConfig.Application.ACLs = map[string]string{"qscc/GetBlockByNumber": "/Channel/Application/Readers"}
+		block, err := channel.NewGenesisBlock("mychannel", genesisConfig)
+		Expect(err).NotTo(HaveOccurred())
+
+		channelConfig, err := channel.ChannelConfigFromBlock(block)
+		Expect(err).NotTo(HaveOccurred())
+
+		acls, err := channelConfig.ACLs()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(acls).To(Equal(genesisConfig.Application.ACLs))
+	})
+
+	It("Reads policies with mod policies", func() {
+		policies, err := channelConfig.Policies(channel.OrdererGroupKey)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(policies).To(HaveKey(channel.BlockValidationPolicyKey))
+		Expect(policies[channel.AdminsPolicyKey].ModPolicy).To(Equal(channel.AdminsPolicyKey))
+		Expect(policies[channel.AdminsPolicyKey].Policy.GetType()).To(BeEquivalentTo(cb.Policy_IMPLICIT_META))
+
+		modPolicy, err := channelConfig.ModPolicy(channel.ApplicationGroupKey)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(modPolicy).To(Equal(channel.AdminsPolicyKey))
+	})
+
+	It("Is not affected by changes to the returned config", func() {
+		config := channelConfig.Config()
+		delete(config.GetChannelGroup().GetGroups()[channel.ApplicationGroupKey].GetGroups(), "Org1MSP")
+
+		orgs, err := channelConfig.ApplicationOrganizations()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(orgs).To(HaveLen(2))
+	})
+})
@@ -0,0 +1,32 @@
+package channel
+
+import (
+	icc "github.com/hyperledger/fabric-admin-sdk/internal/channelconfig"
+	"github.com/hyperledger/fabric-admin-sdk/internal/protoutil"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"google.golang.org/protobuf/proto"
+)
+
+// setValue stores a config value in a config group. The mod policy of an existing value is retained; otherwise the
+// supplied mod policy is used.
+func setValue(group *cb.ConfigGroup, value icc.ConfigValue, modPolicy string) {
+	setConfigValue(group, value.Key(), value.Value(), modPolicy)
+}
+
+// setConfigValue stores a message as a config value in a config group. The mod policy of an existing value is
+// retained; otherwise the supplied mod policy is used.
+func setConfigValue(group *cb.ConfigGroup, key string, message proto.Message, modPolicy string) {
+	if group.Values == nil {
+		group.Values = make(map[string]*cb.ConfigValue)
+	}
+
+	if existing, ok := group.GetValues()[key]; ok {
+		modPolicy = existing.GetModPolicy()
+	}
+
+	group.Values[key] = &cb.ConfigValue{
+		Value:     protoutil.MarshalOrPanic(message),
+		ModPolicy: modPolicy,
+	}
+}
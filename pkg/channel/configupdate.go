@@ -0,0 +1,190 @@
+package channel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hyperledger/fabric-admin-sdk/internal/protoutil"
+	"github.com/hyperledger/fabric-admin-sdk/internal/util"
+	"github.com/hyperledger/fabric-admin-sdk/pkg/identity"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	ab "github.com/hyperledger/fabric-protos-go-apiv2/orderer"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	configUpdateMsgVersion = int32(0)
+	configUpdateEpoch      = uint64(0)
+)
+
+// ConfigUpdate is a channel configuration update along with the signatures collected for it. Each organization
+// administrator whose approval is required adds a signature using Sign. The serialized form returned by Bytes can be
+// passed between organizations and restored using ParseConfigUpdate. Once sufficient signatures have been collected,
+// the update is sent to the ordering service using Submit.
+type ConfigUpdate struct {
+	envelope *cb.ConfigUpdateEnvelope
+}
+
+// NewConfigUpdate wraps a config update envelope, such as one created by NewConfigUpdateEnvelope.
+func NewConfigUpdate(envelope *cb.ConfigUpdateEnvelope) *ConfigUpdate {
+	return &ConfigUpdate{
+		envelope: envelope,
+	}
+}
+
+// ParseConfigUpdate restores a config update from its serialized form, as returned by Bytes.
+func ParseConfigUpdate(configUpdateEnvelope []byte) (*ConfigUpdate, error) {
+	envelope, err := protoutil.UnmarshalConfigUpdateEnvelope(configUpdateEnvelope)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewConfigUpdate(envelope), nil
+}
+
+// ConfigUpdateFromEnvelope extracts a config update from a HeaderType_CONFIG_UPDATE transaction envelope, such as
+// one created by the peer CLI. Any signatures already present are retained.
+func ConfigUpdateFromEnvelope(envelope *cb.Envelope) (*ConfigUpdate, error) {
+	payload, err := protoutil.UnmarshalPayload(envelope.GetPayload())
+	if err != nil {
+		return nil, err
+	}
+
+	if payload.GetHeader() == nil {
+		return nil, errors.New("envelope contains no header")
+	}
+
+	channelHeader, err := protoutil.UnmarshalChannelHeader(payload.GetHeader().GetChannelHeader())
+	if err != nil {
+		return nil, err
+	}
+
+	if channelHeader.GetType() != int32(cb.HeaderType_CONFIG_UPDATE) {
+		return nil, fmt.Errorf("envelope is not a config update, header type: %s", cb.HeaderType(channelHeader.GetType()))
+	}
+
+	return ParseConfigUpdate(payload.GetData())
+}
+
+// Envelope returns the config update envelope, including any signatures collected so far.
+func (u *ConfigUpdate) Envelope() *cb.ConfigUpdateEnvelope {
+	return u.envelope
+}
+
+// Bytes returns the serialized config update envelope, including any signatures collected so far.
+func (u *ConfigUpdate) Bytes() ([]byte, error) {
+	result, err := proto.Marshal(u.envelope)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal config update envelope: %w", err)
+	}
+
+	return result, nil
+}
+
+// ConfigUpdate returns the unmarshaled config update.
+func (u *ConfigUpdate) ConfigUpdate() (*cb.ConfigUpdate, error) {
+	configUpdate := &cb.ConfigUpdate{}
+	if err := proto.Unmarshal(u.envelope.GetConfigUpdate(), configUpdate); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal config update: %w", err)
+	}
+
+	return configUpdate, nil
+}
+
+// ChannelID of the channel to which the config update applies.
+func (u *ConfigUpdate) ChannelID() (string, error) {
+	configUpdate, err := u.ConfigUpdate()
+	if err != nil {
+		return "", err
+	}
+
+	return configUpdate.GetChannelId(), nil
+}
+
+// Signatures collected for the config update so far.
+func (u *ConfigUpdate) Signatures() []*cb.ConfigSignature {
+	return u.envelope.GetSignatures()
+}
+
+// Sign the config update using the supplied identity, and add the resulting signature to those already collected.
+func (u *ConfigUpdate) Sign(id identity.SigningIdentity) error {
+	signatureHeader, err := protoutil.NewSignatureHeader(id)
+	if err != nil {
+		return fmt.Errorf("failed to create signature header: %w", err)
+	}
+
+	signatureHeaderBytes, err := proto.Marshal(signatureHeader)
+	if err != nil {
+		return fmt.Errorf("failed to marshal signature header: %w", err)
+	}
+
+	signature, err := id.Sign(util.Concatenate(signatureHeaderBytes, u.envelope.GetConfigUpdate()))
+	if err != nil {
+		return fmt.Errorf("failed to sign config update: %w", err)
+	}
+
+	u.envelope.Signatures = append(u.envelope.Signatures, &cb.ConfigSignature{
+		SignatureHeader: signatureHeaderBytes,
+		Signature:       signature,
+	})
+
+	return nil
+}
+
+// NewTransaction creates a HeaderType_CONFIG_UPDATE transaction envelope containing the config update and the
+// signatures collected so far, signed by the supplied identity.
+func (u *ConfigUpdate) NewTransaction(id identity.SigningIdentity) (*cb.Envelope, error) {
+	channelID, err := u.ChannelID()
+	if err != nil {
+		return nil, err
+	}
+
+	if channelID == "" {
+		return nil, errors.New("config update does not specify a channel ID")
+	}
+
+	return protoutil.CreateSignedEnvelope(cb.HeaderType_CONFIG_UPDATE, channelID, id, u.envelope, configUpdateMsgVersion, configUpdateEpoch)
+}
+
+// Submit the config update to the ordering service as a transaction signed by the supplied identity. The gRPC
+// connection must be to an ordering service node.
+func (u *ConfigUpdate) Submit(ctx context.Context, connection grpc.ClientConnInterface, id identity.SigningIdentity) error {
+	transaction, err := u.NewTransaction(id)
+	if err != nil {
+		return err
+	}
+
+	return broadcast(ctx, connection, transaction)
+}
+
+func broadcast(ctx context.Context, connection grpc.ClientConnInterface, envelope *cb.Envelope) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	broadcastClient, err := ab.NewAtomicBroadcastClient(connection).Broadcast(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create broadcast client: %w", err)
+	}
+
+	if err := broadcastClient.Send(envelope); err != nil {
+		return fmt.Errorf("failed to send transaction: %w", err)
+	}
+
+	response, err := broadcastClient.Recv()
+	if err != nil {
+		return fmt.Errorf("failed to receive broadcast response: %w", err)
+	}
+
+	if err := broadcastClient.CloseSend(); err != nil {
+		return fmt.Errorf("failed to close broadcast client: %w", err)
+	}
+
+	if response.GetStatus() != cb.Status_SUCCESS {
+		return fmt.Errorf("transaction rejected by orderer with status %d (%s): %s", response.GetStatus(), response.GetStatus(), response.GetInfo())
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package channel_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+
+	"github.com/hyperledger/fabric-admin-sdk/internal/protoutil"
+	"github.com/hyperledger/fabric-admin-sdk/pkg/channel"
+	"github.com/hyperledger/fabric-admin-sdk/pkg/identity"
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"github.com/hyperledger/fabric-protos-go-apiv2/msp"
+	ab "github.com/hyperledger/fabric-protos-go-apiv2/orderer"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+)
+
+func NewSigningIdentity(mspID string) (identity.SigningIdentity, *ecdsa.PrivateKey) {
+	privateKey, err := NewECDSAPrivateKey()
+	Expect(err).NotTo(HaveOccurred())
+
+	certificate, err := NewCertificate(privateKey)
+	Expect(err).NotTo(HaveOccurred())
+
+	id, err := identity.NewPrivateKeySigningIdentity(mspID, certificate, privateKey)
+	Expect(err).NotTo(HaveOccurred())
+
+	return id, privateKey
+}
+
+func NewTestConfigUpdate(channelID string) *channel.ConfigUpdate {
+	config, err := channel.ConfigFromBlock(NewTestConfigBlock(channelID))
+	Expect(err).NotTo(HaveOccurred())
+
+	envelope, err := channel.UpdateConfig(channelID, config, func(updated *cb.Config) error {
+		updated.ChannelGroup.Groups["Orderer"].Values["BatchSize"].Value = AssertMarshal(&ab.BatchSize{MaxMessageCount: 20})
+		return nil
+	})
+	Expect(err).NotTo(HaveOccurred())
+
+	return channel.NewConfigUpdate(envelope)
+}
+
+var _ = Describe("ConfigUpdate", func() {
+	var configUpdate *channel.ConfigUpdate
+
+	BeforeEach(func() {
+		configUpdate = NewTestConfigUpdate("mychannel")
+	})
+
+	It("Has channel ID", func() {
+		channelID, err := configUpdate.ChannelID()
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(channelID).To(Equal("mychannel"))
+	})
+
+	It("Adds valid signature for each signer", func() {
+		org1, org1Key := NewSigningIdentity("Org1MSP")
+		org2, _ := NewSigningIdentity("Org2MSP")
+
+		Expect(configUpdate.Sign(org1)).To(Succeed())
+		Expect(configUpdate.Sign(org2)).To(Succeed())
+
+		signatures := configUpdate.Signatures()
+		Expect(signatures).To(HaveLen(2))
+
+		signatureHeader, err := protoutil.UnmarshalSignatureHeader(signatures[0].GetSignatureHeader())
+		Expect(err).NotTo(HaveOccurred())
+		creator := &msp.SerializedIdentity{}
+		Expect(proto.Unmarshal(signatureHeader.GetCreator(), creator)).To(Succeed())
+		Expect(creator.GetMspid()).To(Equal("Org1MSP"))
+
+		message := append(append([]byte{}, signatures[0].GetSignatureHeader()...), configUpdate.Envelope().GetConfigUpdate()...)
+		digest := sha256.Sum256(message)
+		Expect(ecdsa.VerifyASN1(&org1Key.PublicKey, digest[:], signatures[0].GetSignature())).To(BeTrue())
+	})
+
+	It("Retains signatures when serialized", func() {
+		org1, _ := NewSigningIdentity("Org1MSP")
+		Expect(configUpdate.Sign(org1)).To(Succeed())
+
+		configUpdateBytes, err := configUpdate.Bytes()
+		Expect(err).NotTo(HaveOccurred())
+
+		actual, err := channel.ParseConfigUpdate(configUpdateBytes)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(proto.Equal(actual.Envelope(), configUpdate.Envelope())).To(BeTrue())
+	})
+
+	It("Creates signed config update transaction", func() {
+		org1, _ := NewSigningIdentity("Org1MSP")
+		Expect(configUpdate.Sign(org1)).To(Succeed())
+
+		transaction, err := configUpdate.NewTransaction(org1)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(transaction.GetSignature()).NotTo(BeEmpty())
+
+		actual, err := channel.ConfigUpdateFromEnvelope(transaction)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(proto.Equal(actual.Envelope(), configUpdate.Envelope())).To(BeTrue())
+	})
+
+	It("Rejects envelope that is not a config update", func() {
+		org1, _ := NewSigningIdentity("Org1MSP")
+		envelope, err := protoutil.CreateSignedEnvelope(cb.HeaderType_ENDORSER_TRANSACTION, "mychannel", org1, &cb.ConfigUpdateEnvelope{}, 0, 0)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = channel.ConfigUpdateFromEnvelope(envelope)
+		Expect(err).To(HaveOccurred())
+	})
+})
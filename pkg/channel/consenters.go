@@ -0,0 +1,219 @@
+package channel
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	icc "github.com/hyperledger/fabric-admin-sdk/internal/channelconfig"
+	ipc "github.com/hyperledger/fabric-admin-sdk/internal/policies"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	ab "github.com/hyperledger/fabric-protos-go-apiv2/orderer"
+	"github.com/hyperledger/fabric-protos-go-apiv2/orderer/etcdraft"
+	"google.golang.org/protobuf/proto"
+)
+
+// AddConsenter creates an unsigned config update that adds an ordering service node to the consenter set of a channel
+// using either etcdraft or BFT consensus. For BFT consensus, a consenter with no ID is assigned the next unused ID, and
+// the BlockValidation policy is recomputed for the new consenter set.
+func AddConsenter(channelID string, current *cb.Config, consenter *Consenter) (*ConfigUpdate, error) {
+	return updateConsenters(channelID, current, func(ordererType string, existing []*Consenter) ([]*Consenter, error) {
+		if err := consenter.validate(); err != nil {
+			return nil, err
+		}
+
+		added := *consenter
+		if ordererType == ConsensusTypeBFT && added.ID == 0 {
+			added.ID = nextConsenterID(existing)
+		}
+
+		for _, c := range existing {
+			if c.Host == added.Host && c.Port == added.Port {
+				return nil, fmt.Errorf("consenter %s:%d already exists", added.Host, added.Port)
+			}
+			if ordererType == ConsensusTypeBFT && c.ID == added.ID {
+				return nil, fmt.Errorf("consenter ID %d is already used by %s:%d", added.ID, c.Host, c.Port)
+			}
+		}
+
+		return append(existing, &added), nil
+	})
+}
+
+// RemoveConsenter creates an unsigned config update that removes the ordering service node with the specified host
+// and port from the consenter set of a channel.
+func RemoveConsenter(channelID string, current *cb.Config, host string, port uint32) (*ConfigUpdate, error) {
+	return updateConsenters(channelID, current, func(_ string, existing []*Consenter) ([]*Consenter, error) {
+		var result []*Consenter
+		for _, c := range existing {
+			if c.Host != host || c.Port != port {
+				result = append(result, c)
+			}
+		}
+
+		if len(result) == len(existing) {
+			return nil, fmt.Errorf("consenter %s:%d does not exist", host, port)
+		}
+
+		return result, nil
+	})
+}
+
+// UpdateConsenterTLSCerts creates an unsigned config update that replaces the TLS certificates of the ordering
+// service node with the specified host and port. A nil certificate leaves the existing certificate unchanged.
+func UpdateConsenterTLSCerts(channelID string, current *cb.Config, host string, port uint32, clientTLSCert, serverTLSCert []byte) (*ConfigUpdate, error) {
+	if len(clientTLSCert) == 0 && len(serverTLSCert) == 0 {
+		return nil, errors.New("no TLS certificates specified")
+	}
+
+	return updateConsenters(channelID, current, func(_ string, existing []*Consenter) ([]*Consenter, error) {
+		for _, c := range existing {
+			if c.Host != host || c.Port != port {
+				continue
+			}
+
+			if len(clientTLSCert) > 0 {
+				c.ClientTLSCert = clientTLSCert
+			}
+			if len(serverTLSCert) > 0 {
+				c.ServerTLSCert = serverTLSCert
+			}
+
+			return existing, nil
+		}
+
+		return nil, fmt.Errorf("consenter %s:%d does not exist", host, port)
+	})
+}
+
+func updateConsenters(
+	channelID string,
+	current *cb.Config,
+	modify func(ordererType string, existing []*Consenter) ([]*Consenter, error),
+) (*ConfigUpdate, error) {
+	return newConfigUpdate(channelID, current, func(config *cb.Config) error {
+		channelConfig := &ChannelConfig{config: config}
+
+		ordererType, err := channelConfig.OrdererType()
+		if err != nil {
+			return err
+		}
+
+		existing, err := channelConfig.Consenters()
+		if err != nil {
+			return err
+		}
+
+		updated, err := modify(ordererType, existing)
+		if err != nil {
+			return err
+		}
+		if len(updated) == 0 {
+			return errors.New("cannot remove all consenters")
+		}
+
+		ordererGroup, err := findGroup(config.GetChannelGroup(), OrdererGroupKey)
+		if err != nil {
+			return err
+		}
+
+		return setConsenters(current, ordererGroup, ordererType, updated)
+	})
+}
+
+func setConsenters(current *cb.Config, ordererGroup *cb.ConfigGroup, ordererType string, updated []*Consenter) error {
+	switch ordererType {
+	case ConsensusTypeEtcdRaft:
+		return setEtcdRaftConsenters(ordererGroup, updated)
+	case ConsensusTypeBFT:
+		// The previous consenter set is read from the unmodified config, since modify may alter the consenters it is
+		// given
+		previous, err := (&ChannelConfig{config: current}).Consenters()
+		if err != nil {
+			return err
+		}
+		if err := checkBFTQuorum(previous, updated); err != nil {
+			return err
+		}
+		return setBFTConsenters(ordererGroup, updated)
+	default:
+		return fmt.Errorf("unsupported orderer type: %s", ordererType)
+	}
+}
+
+func setEtcdRaftConsenters(ordererGroup *cb.ConfigGroup, consenters []*Consenter) error {
+	consensusType := &ab.ConsensusType{}
+	if err := unmarshalValue(ordererGroup, ConsensusTypeKey, consensusType); err != nil {
+		return err
+	}
+
+	metadata := &etcdraft.ConfigMetadata{}
+	if err := proto.Unmarshal(consensusType.GetMetadata(), metadata); err != nil {
+		return fmt.Errorf("failed to unmarshal etcdraft metadata: %w", err)
+	}
+
+	raftConsenters, err := etcdRaftConsenters(consenters)
+	if err != nil {
+		return err
+	}
+	metadata.Consenters = raftConsenters
+
+	if consensusType.Metadata, err = proto.Marshal(metadata); err != nil {
+		return fmt.Errorf("failed to marshal etcdraft metadata: %w", err)
+	}
+
+	setConfigValue(ordererGroup, ConsensusTypeKey, consensusType, AdminsPolicyKey)
+	return nil
+}
+
+func setBFTConsenters(ordererGroup *cb.ConfigGroup, consenters []*Consenter) error {
+	consenterProtos, err := bftConsenters(consenters)
+	if err != nil {
+		return err
+	}
+
+	setValue(ordererGroup, icc.OrderersValue(consenterProtos), AdminsPolicyKey)
+	ipc.EncodeBFTBlockVerificationPolicy(consenterProtos, ordererGroup)
+
+	return nil
+}
+
+// checkBFTQuorum ensures that the consenters retained from the previous consenter set are able to form a quorum in
+// both the previous and the updated consenter sets, so that consensus can continue across the configuration change.
+func checkBFTQuorum(previous, updated []*Consenter) error {
+	var retained int
+	for _, p := range previous {
+		for _, u := range updated {
+			if p.ID == u.ID && p.MSPID == u.MSPID && bytes.Equal(p.Identity, u.Identity) {
+				retained++
+				break
+			}
+		}
+	}
+
+	previousQuorum := bftQuorum(len(previous))
+	if retained < previousQuorum {
+		return fmt.Errorf("change retains %d of %d consenters, fewer than the current quorum of %d", retained, len(previous), previousQuorum)
+	}
+
+	updatedQuorum := bftQuorum(len(updated))
+	if retained < updatedQuorum {
+		return fmt.Errorf("change retains %d of %d consenters, fewer than the new quorum of %d", retained, len(updated), updatedQuorum)
+	}
+
+	return nil
+}
+
+func bftQuorum(consenterCount int) int {
+	return int(ipc.ComputeBFTQuorum(consenterCount, (consenterCount-1)/3))
+}
+
+func nextConsenterID(consenters []*Consenter) uint32 {
+	var result uint32
+	for _, c := range consenters {
+		result = max(result, c.ID)
+	}
+
+	return result + 1
+}
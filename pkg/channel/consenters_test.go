@@ -0,0 +1,184 @@
+package channel_test
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/fabric-admin-sdk/pkg/channel"
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+)
+
+func NewTestBFTConfig(consenterCount int) *cb.Config {
+	tlsCert := ReadTestFile("ordererOrganizations", "example.com", "orderers", "orderer.example.com", "tls", "server.crt")
+	identity := ReadTestFile("ordererOrganizations", "example.com", "orderers", "orderer.example.com", "msp", "signcerts", "orderer.example.com-cert.pem")
+
+	genesisConfig := NewTestGenesisConfig()
+	genesisConfig.Orderer.OrdererType = channel.ConsensusTypeBFT
+	genesisConfig.Orderer.Consenters = nil
+	for i := 1; i <= consenterCount; i++ {
+		genesisConfig.Orderer.Consenters = append(genesisConfig.Orderer.Consenters, NewTestBFTConsenter(uint32(i), tlsCert, identity))
+	}
+
+	block, err := channel.NewGenesisBlock("mychannel", genesisConfig)
+	Expect(err).NotTo(HaveOccurred())
+
+	config, err := channel.ConfigFromBlock(block)
+	Expect(err).NotTo(HaveOccurred())
+	return config
+}
+
+func NewTestBFTConsenter(id uint32, tlsCert, identity []byte) *channel.Consenter {
+	return &channel.Consenter{
+		ID:            id,
+		Host:          fmt.Sprintf("orderer%d.example.com", id),
+		Port:          7050,
+		MSPID:         "OrdererMSP",
+		Identity:      identity,
+		ClientTLSCert: tlsCert,
+		ServerTLSCert: tlsCert,
+	}
+}
+
+func AssertChannelConfig(config *cb.Config) *channel.ChannelConfig {
+	result, err := channel.NewChannelConfig(config)
+	Expect(err).NotTo(HaveOccurred())
+	return result
+}
+
+func UpdatedConfig(config *cb.Config, configUpdate *channel.ConfigUpdate) *channel.ChannelConfig {
+	// Apply write set values over the original config to inspect the result
+	update := AssertConfigUpdate(configUpdate)
+	updated := proto.Clone(config).(*cb.Config)
+	ordererGroup := updated.GetChannelGroup().GetGroups()[channel.OrdererGroupKey]
+	writeGroup := update.GetWriteSet().GetGroups()[channel.OrdererGroupKey]
+	for key, value := range writeGroup.GetValues() {
+		ordererGroup.Values[key] = value
+	}
+	for key, policy := range writeGroup.GetPolicies() {
+		ordererGroup.Policies[key] = policy
+	}
+
+	return AssertChannelConfig(updated)
+}
+
+func BlockValidationPolicy(channelConfig *channel.ChannelConfig) *cb.SignaturePolicyEnvelope {
+	policies, err := channelConfig.Policies(channel.OrdererGroupKey)
+	Expect(err).NotTo(HaveOccurred())
+
+	result := &cb.SignaturePolicyEnvelope{}
+	Expect(proto.Unmarshal(policies[channel.BlockValidationPolicyKey].Policy.GetValue(), result)).To(Succeed())
+	return result
+}
+
+var _ = Describe("Consenters", func() {
+	tlsCert := []byte("TLS_CERT")
+
+	Context("etcdraft", func() {
+		var config *cb.Config
+
+		BeforeEach(func() {
+			config = NewTestConfig()
+		})
+
+		It("Adds consenter", func() {
+			configUpdate, err := channel.AddConsenter("mychannel", config, &channel.Consenter{
+				Host:          "orderer2.example.com",
+				Port:          7050,
+				ClientTLSCert: tlsCert,
+				ServerTLSCert: tlsCert,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			consenters, err := UpdatedConfig(config, configUpdate).Consenters()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(consenters).To(HaveLen(2))
+			Expect(consenters[1].Host).To(Equal("orderer2.example.com"))
+		})
+
+		It("Fails to add existing consenter", func() {
+			_, err := channel.AddConsenter("mychannel", config, &channel.Consenter{
+				Host:          "orderer.example.com",
+				Port:          7050,
+				ClientTLSCert: tlsCert,
+				ServerTLSCert: tlsCert,
+			})
+			Expect(err).To(MatchError(ContainSubstring("already exists")))
+		})
+
+		It("Fails to remove last consenter", func() {
+			_, err := channel.RemoveConsenter("mychannel", config, "orderer.example.com", 7050)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("Replaces consenter TLS certificates", func() {
+			configUpdate, err := channel.UpdateConsenterTLSCerts("mychannel", config, "orderer.example.com", 7050, nil, tlsCert)
+			Expect(err).NotTo(HaveOccurred())
+
+			consenters, err := UpdatedConfig(config, configUpdate).Consenters()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(consenters).To(HaveLen(1))
+			Expect(consenters[0].ServerTLSCert).To(Equal(tlsCert))
+			Expect(consenters[0].ClientTLSCert).NotTo(Equal(tlsCert))
+		})
+
+		It("Fails to replace TLS certificates for unknown consenter", func() {
+			_, err := channel.UpdateConsenterTLSCerts("mychannel", config, "orderer9.example.com", 7050, tlsCert, tlsCert)
+			Expect(err).To(MatchError(ContainSubstring("does not exist")))
+		})
+	})
+
+	Context("BFT", func() {
+		It("Adds consenter and recomputes block validation policy", func() {
+			config := NewTestBFTConfig(4)
+			existing, err := AssertChannelConfig(config).Consenters()
+			Expect(err).NotTo(HaveOccurred())
+
+			added := NewTestBFTConsenter(0, tlsCert, existing[0].Identity)
+			configUpdate, err := channel.AddConsenter("mychannel", config, added)
+			Expect(err).NotTo(HaveOccurred())
+
+			updated := UpdatedConfig(config, configUpdate)
+			consenters, err := updated.Consenters()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(consenters).To(HaveLen(5))
+			Expect(consenters[4].ID).To(BeEquivalentTo(5))
+
+			policy := BlockValidationPolicy(updated)
+			Expect(policy.GetIdentities()).To(HaveLen(5))
+			Expect(policy.GetRule().GetNOutOf().GetN()).To(BeEquivalentTo(4))
+		})
+
+		It("Removes consenter", func() {
+			config := NewTestBFTConfig(4)
+
+			configUpdate, err := channel.RemoveConsenter("mychannel", config, "orderer4.example.com", 7050)
+			Expect(err).NotTo(HaveOccurred())
+
+			updated := UpdatedConfig(config, configUpdate)
+			consenters, err := updated.Consenters()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(consenters).To(HaveLen(3))
+			Expect(BlockValidationPolicy(updated).GetIdentities()).To(HaveLen(3))
+		})
+
+		It("Refuses change that breaks quorum", func() {
+			config := NewTestBFTConfig(2)
+
+			_, err := channel.RemoveConsenter("mychannel", config, "orderer2.example.com", 7050)
+			Expect(err).To(MatchError(ContainSubstring("quorum")))
+		})
+
+		It("Fails to add consenter with duplicate ID", func() {
+			config := NewTestBFTConfig(4)
+			existing, err := AssertChannelConfig(config).Consenters()
+			Expect(err).NotTo(HaveOccurred())
+
+			added := NewTestBFTConsenter(1, tlsCert, existing[0].Identity)
+			added.Host = "orderer9.example.com"
+			_, err = channel.AddConsenter("mychannel", config, added)
+			Expect(err).To(MatchError(ContainSubstring("already used")))
+		})
+	})
+})
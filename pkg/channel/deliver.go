@@ -0,0 +1,293 @@
+package channel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"math"
+	"time"
+
+	"github.com/hyperledger/fabric-admin-sdk/pkg/identity"
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	ab "github.com/hyperledger/fabric-protos-go-apiv2/orderer"
+	"google.golang.org/grpc"
+)
+
+const (
+	defaultMaxReconnects  = 3
+	defaultReconnectDelay = time.Second
+)
+
+// DeliverStatusError is returned when a Deliver service ends delivery with a status other than SUCCESS. For
+// example, NOT_FOUND is returned if the requested blocks do not exist and delivery is not blocking until they are
+// ready, and FORBIDDEN is returned if the client identity is not authorized to read blocks from the channel.
+type DeliverStatusError struct {
+	Status cb.Status
+}
+
+func (e *DeliverStatusError) Error() string {
+	return fmt.Sprintf("deliver failed with status %d (%s)", int32(e.Status), e.Status)
+}
+
+// SeekOldest returns a seek position identifying the oldest block available.
+func SeekOldest() *ab.SeekPosition {
+	return &ab.SeekPosition{
+		Type: &ab.SeekPosition_Oldest{
+			Oldest: &ab.SeekOldest{},
+		},
+	}
+}
+
+// SeekNewest returns a seek position identifying the newest block available when delivery starts.
+func SeekNewest() *ab.SeekPosition {
+	return &ab.SeekPosition{
+		Type: &ab.SeekPosition_Newest{
+			Newest: &ab.SeekNewest{},
+		},
+	}
+}
+
+// SeekBlock returns a seek position identifying the block with the specified number.
+func SeekBlock(number uint64) *ab.SeekPosition {
+	return &ab.SeekPosition{
+		Type: &ab.SeekPosition_Specified{
+			Specified: &ab.SeekSpecified{
+				Number: number,
+			},
+		},
+	}
+}
+
+// DeliverOption implements an option for block delivery.
+type DeliverOption func(*deliverOptions)
+
+type deliverOptions struct {
+	start          *ab.SeekPosition
+	stop           *ab.SeekPosition
+	behavior       ab.SeekInfo_SeekBehavior
+	errorResponse  ab.SeekInfo_SeekErrorResponse
+	tlsCertHash    []byte
+	maxReconnects  int
+	reconnectDelay time.Duration
+}
+
+func newDeliverOptions(options ...DeliverOption) *deliverOptions {
+	result := &deliverOptions{
+		start:          SeekNewest(),
+		stop:           SeekBlock(math.MaxUint64),
+		behavior:       ab.SeekInfo_BLOCK_UNTIL_READY,
+		errorResponse:  ab.SeekInfo_STRICT,
+		maxReconnects:  defaultMaxReconnects,
+		reconnectDelay: defaultReconnectDelay,
+	}
+
+	for _, option := range options {
+		option(result)
+	}
+
+	return result
+}
+
+// WithSeekRange specifies the first and last blocks to deliver. If stop is nil, delivery continues indefinitely as
+// new blocks are committed. By default, delivery starts at the newest block and continues indefinitely.
+func WithSeekRange(start *ab.SeekPosition, stop *ab.SeekPosition) DeliverOption {
+	return func(o *deliverOptions) {
+		o.start = start
+		o.stop = stop
+		if stop == nil {
+			o.stop = SeekBlock(math.MaxUint64)
+		}
+	}
+}
+
+// WithFailIfNotReady specifies that delivery should end with a NOT_FOUND status error when it reaches a block that
+// does not yet exist, instead of waiting for the block to be committed.
+func WithFailIfNotReady() DeliverOption {
+	return func(o *deliverOptions) {
+		o.behavior = ab.SeekInfo_FAIL_IF_NOT_READY
+	}
+}
+
+// WithBestEffort specifies that delivery should continue if the service is unable to verify that the client is
+// authorized to read from the channel, for example because the service has not caught up with the latest channel
+// configuration.
+func WithBestEffort() DeliverOption {
+	return func(o *deliverOptions) {
+		o.errorResponse = ab.SeekInfo_BEST_EFFORT
+	}
+}
+
+// WithDeliverTLSClientCertificateHash specifies the SHA-256 hash of the TLS client certificate. This option is
+// required only if mutual TLS authentication is used for the gRPC connection.
+func WithDeliverTLSClientCertificateHash(certificateHash []byte) DeliverOption {
+	return func(o *deliverOptions) {
+		o.tlsCertHash = certificateHash
+	}
+}
+
+// WithReconnect specifies the number of consecutive times to reconnect after a stream error, and the delay before
+// each reconnect. Delivery resumes from the block after the last block delivered. A value of zero disables
+// reconnection. By default, up to 3 reconnects are attempted with a delay of 1 second.
+func WithReconnect(maxReconnects int, delay time.Duration) DeliverOption {
+	return func(o *deliverOptions) {
+		o.maxReconnects = maxReconnects
+		o.reconnectDelay = delay
+	}
+}
+
+// Deliver streams blocks from the Deliver service of an orderer. Blocks are delivered in order over the range
+// specified using the WithSeekRange option. Iteration ends when the last block in the range has been delivered, or
+// after an error is returned. Stream errors cause delivery to reconnect and resume after the last block delivered,
+// unless the context is done or the reconnect limit is reached. A status other than SUCCESS from the service is
+// returned as a *DeliverStatusError.
+func Deliver(ctx context.Context, connection grpc.ClientConnInterface, id identity.SigningIdentity, channelID string, options ...DeliverOption) iter.Seq2[*cb.Block, error] {
+	client := ab.NewAtomicBroadcastClient(connection)
+
+	d := &deliverer[*ab.DeliverResponse, *cb.Block]{
+		open: func(ctx context.Context) (deliverStream[*ab.DeliverResponse], error) {
+			return client.Deliver(ctx)
+		},
+		content: func(response *ab.DeliverResponse) (*cb.Block, uint64, bool) {
+			block := response.GetBlock()
+			return block, block.GetHeader().GetNumber(), block != nil
+		},
+		channelID: channelID,
+		signer:    id,
+		options:   newDeliverOptions(options...),
+	}
+
+	return d.deliver(ctx)
+}
+
+// deliverStream is a Deliver stream, which receives responses of type R.
+type deliverStream[R any] interface {
+	Send(*cb.Envelope) error
+	Recv() (R, error)
+}
+
+type deliverResponse interface {
+	GetStatus() cb.Status
+}
+
+// deliverer delivers content of type T, obtained from Deliver stream responses of type R.
+type deliverer[R deliverResponse, T any] struct {
+	open func(ctx context.Context) (deliverStream[R], error)
+
+	// content returns the content of a response along with its block number, or false if the response is a status.
+	content func(response R) (T, uint64, bool)
+
+	channelID string
+	signer    identity.SigningIdentity
+	options   *deliverOptions
+}
+
+func (d *deliverer[R, T]) deliver(ctx context.Context) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		start := d.options.start
+		failures := 0
+
+		for !d.isPastStop(start) {
+			next, err := d.deliverStream(ctx, start, yield)
+			if next != nil {
+				start = next
+				failures = 0
+			}
+			if err == nil {
+				return
+			}
+
+			failures++
+			if !d.reconnect(ctx, err, failures) {
+				var zero T
+				if ctx.Err() != nil {
+					err = ctx.Err()
+				}
+				yield(zero, err)
+				return
+			}
+		}
+	}
+}
+
+// deliverStream delivers content from a single Deliver stream, starting at the specified position. It returns the
+// position from which to resume delivery, or nil if no content was delivered. A nil error is returned if delivery
+// completed successfully or the consumer stopped iterating.
+func (d *deliverer[R, T]) deliverStream(ctx context.Context, start *ab.SeekPosition, yield func(T, error) bool) (*ab.SeekPosition, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := d.open(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open deliver stream: %w", err)
+	}
+
+	envelope, err := seekHelper(d.channelID, d.seekInfo(start), d.options.tlsCertHash, d.signer)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := stream.Send(envelope); err != nil {
+		return nil, fmt.Errorf("failed to send seek request: %w", err)
+	}
+
+	var next *ab.SeekPosition
+	for {
+		response, err := stream.Recv()
+		if err != nil {
+			return next, fmt.Errorf("failed to receive deliver response: %w", err)
+		}
+
+		content, number, ok := d.content(response)
+		if !ok {
+			return next, statusError(response.GetStatus())
+		}
+
+		next = SeekBlock(number + 1)
+		if !yield(content, nil) {
+			return next, nil
+		}
+	}
+}
+
+func (d *deliverer[R, T]) seekInfo(start *ab.SeekPosition) *ab.SeekInfo {
+	return &ab.SeekInfo{
+		Start:         start,
+		Stop:          d.options.stop,
+		Behavior:      d.options.behavior,
+		ErrorResponse: d.options.errorResponse,
+	}
+}
+
+// isPastStop reports whether a resume position is beyond the last block to be delivered.
+func (d *deliverer[R, T]) isPastStop(position *ab.SeekPosition) bool {
+	stop := d.options.stop.GetSpecified()
+	next := position.GetSpecified()
+	return stop != nil && next != nil && next.GetNumber() > stop.GetNumber()
+}
+
+// reconnect waits before reconnecting after a stream error, and reports whether reconnection should be attempted.
+func (d *deliverer[R, T]) reconnect(ctx context.Context, err error, failures int) bool {
+	var statusErr *DeliverStatusError
+	if errors.As(err, &statusErr) || failures > d.options.maxReconnects {
+		return false
+	}
+
+	timer := time.NewTimer(d.options.reconnectDelay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+func statusError(status cb.Status) error {
+	if status == cb.Status_SUCCESS {
+		return nil
+	}
+
+	return &DeliverStatusError{Status: status}
+}
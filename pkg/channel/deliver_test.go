@@ -0,0 +1,307 @@
+package channel_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+
+	"github.com/hyperledger/fabric-admin-sdk/pkg/channel"
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	ab "github.com/hyperledger/fabric-protos-go-apiv2/orderer"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+//go:generate mockgen -destination ./clientstream_mock_test.go -package ${GOPACKAGE} google.golang.org/grpc ClientStream
+
+const ordererDeliverMethod = "/orderer.AtomicBroadcast/Deliver"
+
+// SeekRequest is a seek request received by a mock Deliver service.
+type SeekRequest struct {
+	SeekInfo    *ab.SeekInfo
+	TLSCertHash []byte
+}
+
+// NewMockDeliverConnection returns a connection that opens a new mock Deliver stream for each script supplied. Each
+// script is a sequence of responses, of type proto.Message, or errors returned when receiving from the stream. Seek
+// requests sent to the streams are recorded.
+func NewMockDeliverConnection(controller *gomock.Controller, method string, requests *[]*SeekRequest, scripts ...[]any) *MockClientConnInterface {
+	connection := NewMockClientConnInterface(controller)
+	connection.EXPECT().
+		NewStream(gomock.Any(), gomock.Any(), gomock.Eq(method), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+
+			Expect(len(scripts)).To(BeNumerically(">", len(*requests)), "unexpected stream")
+			return NewMockDeliverStream(controller, requests, scripts[len(*requests)]), nil
+		}).
+		AnyTimes()
+
+	return connection
+}
+
+func NewMockDeliverStream(controller *gomock.Controller, requests *[]*SeekRequest, script []any) *MockClientStream {
+	stream := NewMockClientStream(controller)
+	stream.EXPECT().
+		SendMsg(gomock.Any()).
+		DoAndReturn(func(message any) error {
+			*requests = append(*requests, SeekRequestFromEnvelope(message.(*cb.Envelope)))
+			return nil
+		}).
+		AnyTimes()
+	stream.EXPECT().
+		RecvMsg(gomock.Any()).
+		DoAndReturn(func(message any) error {
+			if len(script) == 0 {
+				return io.EOF
+			}
+
+			next := script[0]
+			script = script[1:]
+
+			if err, ok := next.(error); ok {
+				return err
+			}
+			proto.Merge(message.(proto.Message), next.(proto.Message))
+			return nil
+		}).
+		AnyTimes()
+	stream.EXPECT().CloseSend().Return(nil).AnyTimes()
+
+	return stream
+}
+
+func SeekRequestFromEnvelope(envelope *cb.Envelope) *SeekRequest {
+	payload := &cb.Payload{}
+	Expect(proto.Unmarshal(envelope.GetPayload(), payload)).To(Succeed())
+
+	channelHeader := &cb.ChannelHeader{}
+	Expect(proto.Unmarshal(payload.GetHeader().GetChannelHeader(), channelHeader)).To(Succeed())
+	Expect(channelHeader.GetType()).To(Equal(int32(cb.HeaderType_DELIVER_SEEK_INFO)))
+	Expect(channelHeader.GetChannelId()).To(Equal("mychannel"))
+
+	seekInfo := &ab.SeekInfo{}
+	Expect(proto.Unmarshal(payload.GetData(), seekInfo)).To(Succeed())
+
+	return &SeekRequest{
+		SeekInfo:    seekInfo,
+		TLSCertHash: channelHeader.GetTlsCertHash(),
+	}
+}
+
+func NewOrdererBlockResponse(number uint64) *ab.DeliverResponse {
+	return &ab.DeliverResponse{
+		Type: &ab.DeliverResponse_Block{
+			Block: &cb.Block{
+				Header: &cb.BlockHeader{Number: number},
+			},
+		},
+	}
+}
+
+func NewOrdererStatusResponse(status cb.Status) *ab.DeliverResponse {
+	return &ab.DeliverResponse{
+		Type: &ab.DeliverResponse_Status{
+			Status: status,
+		},
+	}
+}
+
+func AssertSeekPosition(expected *ab.SeekPosition, actual *ab.SeekPosition) {
+	Expect(proto.Equal(expected, actual)).To(BeTrue(), "Expected %v, got %v", expected, actual)
+}
+
+var _ = Describe("Deliver", func() {
+	var requests []*SeekRequest
+
+	BeforeEach(func() {
+		requests = nil
+	})
+
+	ReadBlockNumbers := func(blocks func(func(*cb.Block, error) bool)) ([]uint64, error) {
+		var result []uint64
+		for block, err := range blocks {
+			if err != nil {
+				return result, err
+			}
+			result = append(result, block.GetHeader().GetNumber())
+		}
+		return result, nil
+	}
+
+	It("Delivers blocks in specified range", func(specCtx SpecContext) {
+		controller := gomock.NewController(GinkgoT())
+		connection := NewMockDeliverConnection(controller, ordererDeliverMethod, &requests, []any{
+			NewOrdererBlockResponse(5),
+			NewOrdererBlockResponse(6),
+			NewOrdererBlockResponse(7),
+			NewOrdererStatusResponse(cb.Status_SUCCESS),
+		})
+		id := NewTestOrgAdmin("org1.example.com", "Org1MSP")
+
+		actual, err := ReadBlockNumbers(channel.Deliver(specCtx, connection, id, "mychannel",
+			channel.WithSeekRange(channel.SeekBlock(5), channel.SeekBlock(7)),
+			channel.WithDeliverTLSClientCertificateHash([]byte("TLS_CERT_HASH")),
+		))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(actual).To(Equal([]uint64{5, 6, 7}))
+		Expect(requests).To(HaveLen(1))
+		AssertSeekPosition(channel.SeekBlock(5), requests[0].SeekInfo.GetStart())
+		AssertSeekPosition(channel.SeekBlock(7), requests[0].SeekInfo.GetStop())
+		Expect(requests[0].SeekInfo.GetBehavior()).To(Equal(ab.SeekInfo_BLOCK_UNTIL_READY))
+		Expect(requests[0].SeekInfo.GetErrorResponse()).To(Equal(ab.SeekInfo_STRICT))
+		Expect(requests[0].TLSCertHash).To(Equal([]byte("TLS_CERT_HASH")))
+	})
+
+	It("Delivers from newest block indefinitely by default", func(specCtx SpecContext) {
+		controller := gomock.NewController(GinkgoT())
+		connection := NewMockDeliverConnection(controller, ordererDeliverMethod, &requests, []any{
+			NewOrdererBlockResponse(9),
+			NewOrdererBlockResponse(10),
+			NewOrdererBlockResponse(11),
+		})
+		id := NewTestOrgAdmin("org1.example.com", "Org1MSP")
+
+		var actual []uint64
+		for block, err := range channel.Deliver(specCtx, connection, id, "mychannel") {
+			Expect(err).NotTo(HaveOccurred())
+			actual = append(actual, block.GetHeader().GetNumber())
+			if len(actual) == 2 {
+				break
+			}
+		}
+
+		Expect(actual).To(Equal([]uint64{9, 10}))
+		Expect(requests).To(HaveLen(1))
+		AssertSeekPosition(channel.SeekNewest(), requests[0].SeekInfo.GetStart())
+		AssertSeekPosition(channel.SeekBlock(math.MaxUint64), requests[0].SeekInfo.GetStop())
+	})
+
+	It("Seek behavior options", func(specCtx SpecContext) {
+		controller := gomock.NewController(GinkgoT())
+		connection := NewMockDeliverConnection(controller, ordererDeliverMethod, &requests, []any{
+			NewOrdererStatusResponse(cb.Status_SUCCESS),
+		})
+		id := NewTestOrgAdmin("org1.example.com", "Org1MSP")
+
+		_, err := ReadBlockNumbers(channel.Deliver(specCtx, connection, id, "mychannel",
+			channel.WithSeekRange(channel.SeekOldest(), channel.SeekNewest()),
+			channel.WithFailIfNotReady(),
+			channel.WithBestEffort(),
+		))
+		Expect(err).NotTo(HaveOccurred())
+
+		AssertSeekPosition(channel.SeekOldest(), requests[0].SeekInfo.GetStart())
+		AssertSeekPosition(channel.SeekNewest(), requests[0].SeekInfo.GetStop())
+		Expect(requests[0].SeekInfo.GetBehavior()).To(Equal(ab.SeekInfo_FAIL_IF_NOT_READY))
+		Expect(requests[0].SeekInfo.GetErrorResponse()).To(Equal(ab.SeekInfo_BEST_EFFORT))
+	})
+
+	It("Status error returned without reconnect", func(specCtx SpecContext) {
+		controller := gomock.NewController(GinkgoT())
+		connection := NewMockDeliverConnection(controller, ordererDeliverMethod, &requests, []any{
+			NewOrdererBlockResponse(5),
+			NewOrdererStatusResponse(cb.Status_NOT_FOUND),
+		})
+		id := NewTestOrgAdmin("org1.example.com", "Org1MSP")
+
+		actual, err := ReadBlockNumbers(channel.Deliver(specCtx, connection, id, "mychannel",
+			channel.WithSeekRange(channel.SeekBlock(5), channel.SeekBlock(7)),
+			channel.WithFailIfNotReady(),
+			channel.WithReconnect(3, 0),
+		))
+
+		Expect(actual).To(Equal([]uint64{5}))
+		var statusErr *channel.DeliverStatusError
+		Expect(errors.As(err, &statusErr)).To(BeTrue(), "Expected DeliverStatusError, got %v", err)
+		Expect(statusErr.Status).To(Equal(cb.Status_NOT_FOUND))
+		Expect(requests).To(HaveLen(1))
+	})
+
+	It("Reconnects from checkpoint after stream error", func(specCtx SpecContext) {
+		controller := gomock.NewController(GinkgoT())
+		connection := NewMockDeliverConnection(controller, ordererDeliverMethod, &requests,
+			[]any{
+				NewOrdererBlockResponse(5),
+				NewOrdererBlockResponse(6),
+				errors.New("STREAM_ERROR"),
+			},
+			[]any{
+				errors.New("STREAM_ERROR"),
+			},
+			[]any{
+				NewOrdererBlockResponse(7),
+				NewOrdererStatusResponse(cb.Status_SUCCESS),
+			},
+		)
+		id := NewTestOrgAdmin("org1.example.com", "Org1MSP")
+
+		actual, err := ReadBlockNumbers(channel.Deliver(specCtx, connection, id, "mychannel",
+			channel.WithSeekRange(channel.SeekBlock(5), channel.SeekBlock(7)),
+			channel.WithReconnect(3, 0),
+		))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(actual).To(Equal([]uint64{5, 6, 7}))
+		Expect(requests).To(HaveLen(3))
+		AssertSeekPosition(channel.SeekBlock(7), requests[1].SeekInfo.GetStart())
+		AssertSeekPosition(channel.SeekBlock(7), requests[2].SeekInfo.GetStart())
+		AssertSeekPosition(channel.SeekBlock(7), requests[2].SeekInfo.GetStop())
+	})
+
+	It("Completes without reconnect if stream fails after last block", func(specCtx SpecContext) {
+		controller := gomock.NewController(GinkgoT())
+		connection := NewMockDeliverConnection(controller, ordererDeliverMethod, &requests, []any{
+			NewOrdererBlockResponse(5),
+			errors.New("STREAM_ERROR"),
+		})
+		id := NewTestOrgAdmin("org1.example.com", "Org1MSP")
+
+		actual, err := ReadBlockNumbers(channel.Deliver(specCtx, connection, id, "mychannel",
+			channel.WithSeekRange(channel.SeekBlock(5), channel.SeekBlock(5)),
+			channel.WithReconnect(3, 0),
+		))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(actual).To(Equal([]uint64{5}))
+		Expect(requests).To(HaveLen(1))
+	})
+
+	It("Stream error returned when reconnect limit reached", func(specCtx SpecContext) {
+		expectedErr := errors.New("EXPECTED_ERROR")
+
+		controller := gomock.NewController(GinkgoT())
+		connection := NewMockDeliverConnection(controller, ordererDeliverMethod, &requests,
+			[]any{expectedErr},
+			[]any{expectedErr},
+			[]any{expectedErr},
+		)
+		id := NewTestOrgAdmin("org1.example.com", "Org1MSP")
+
+		_, err := ReadBlockNumbers(channel.Deliver(specCtx, connection, id, "mychannel", channel.WithReconnect(2, 0)))
+
+		Expect(err).To(MatchError(expectedErr))
+		Expect(requests).To(HaveLen(3))
+	})
+
+	It("Context error returned when context is done", func(specCtx SpecContext) {
+		controller := gomock.NewController(GinkgoT())
+		connection := NewMockDeliverConnection(controller, ordererDeliverMethod, &requests)
+		id := NewTestOrgAdmin("org1.example.com", "Org1MSP")
+
+		ctx, cancel := context.WithCancel(specCtx)
+		cancel()
+
+		_, err := ReadBlockNumbers(channel.Deliver(ctx, connection, id, "mychannel"))
+
+		Expect(err).To(MatchError(context.Canceled))
+		Expect(requests).To(BeEmpty())
+	})
+})
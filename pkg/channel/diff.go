@@ -0,0 +1,426 @@
+package channel
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	icc "github.com/hyperledger/fabric-admin-sdk/internal/channelconfig"
+	"github.com/hyperledger/fabric-admin-sdk/internal/configtxlator/update"
+	imsp "github.com/hyperledger/fabric-admin-sdk/internal/msp"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"github.com/hyperledger/fabric-protos-go-apiv2/msp"
+	ab "github.com/hyperledger/fabric-protos-go-apiv2/orderer"
+	"github.com/hyperledger/fabric-protos-go-apiv2/orderer/etcdraft"
+	"github.com/hyperledger/fabric-protos-go-apiv2/orderer/smartbft"
+	pb "github.com/hyperledger/fabric-protos-go-apiv2/peer"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Types of change to a config element.
+const (
+	ChangeAdded    = "Added"
+	ChangeRemoved  = "Removed"
+	ChangeModified = "Modified"
+)
+
+// ConfigDiff describes the differences between two channel configurations.
+type ConfigDiff struct {
+	Changes []*ConfigChange `json:"changes"`
+}
+
+// ConfigChange describes a config element that was added, removed or modified. Values and policies are decoded into
+// human-readable form, with config values represented in the same way as configtxlator JSON and policies represented
+// as a Policy where possible.
+type ConfigChange struct {
+	// Change is the type of change, such as ChangeModified.
+	Change string `json:"change"`
+
+	// ElementType is the type of config element, such as ConfigElementValue.
+	ElementType string `json:"element_type"`
+
+	// Path of the config element, for example "/Channel/Application/Org1MSP/AnchorPeers".
+	Path string `json:"path"`
+
+	// Old is the decoded content of a removed or modified value or policy.
+	Old any `json:"old,omitempty"`
+
+	// New is the decoded content of an added or modified value or policy.
+	New any `json:"new,omitempty"`
+
+	// OldModPolicy is the mod_policy of a removed or modified element.
+	OldModPolicy string `json:"old_mod_policy,omitempty"`
+
+	// NewModPolicy is the mod_policy of an added or modified element.
+	NewModPolicy string `json:"new_mod_policy,omitempty"`
+}
+
+// DiffConfig compares two channel configurations, and returns the groups, values and policies that were added,
+// removed or modified. Changes are ordered by path.
+func DiffConfig(original, updated *cb.Config) (*ConfigDiff, error) {
+	result := &ConfigDiff{}
+	if err := result.addGroupChanges(nil, original.GetChannelGroup(), updated.GetChannelGroup()); err != nil {
+		return nil, err
+	}
+
+	slices.SortStableFunc(result.Changes, func(a, b *ConfigChange) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	return result, nil
+}
+
+// DiffConfigUpdate compares the current channel configuration with the configuration that would result from
+// applying a config update.
+func DiffConfigUpdate(current *cb.Config, configUpdate *ConfigUpdate) (*ConfigDiff, error) {
+	configUpdateMessage, err := configUpdate.ConfigUpdate()
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := update.Apply(current, configUpdateMessage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply config update: %w", err)
+	}
+
+	return DiffConfig(current, updated)
+}
+
+// JSON returns the diff as indented JSON.
+func (d *ConfigDiff) JSON() ([]byte, error) {
+	result, err := json.MarshalIndent(d, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal config diff: %w", err)
+	}
+
+	return result, nil
+}
+
+// String returns the diff as human-readable text, with one line per changed element followed by indented lines
+// describing the old and new content.
+func (d *ConfigDiff) String() string {
+	var result strings.Builder
+	for _, change := range d.Changes {
+		change.writeText(&result)
+	}
+
+	return result.String()
+}
+
+func (c *ConfigChange) writeText(out *strings.Builder) {
+	var symbol string
+	switch c.Change {
+	case ChangeAdded:
+		symbol = "+"
+	case ChangeRemoved:
+		symbol = "-"
+	default:
+		symbol = "~"
+	}
+
+	fmt.Fprintf(out, "%s %s %s\n", symbol, c.ElementType, c.Path)
+
+	if c.Old != nil {
+		fmt.Fprintf(out, "    old: %s\n", textValue(c.Old))
+	}
+	if c.New != nil {
+		fmt.Fprintf(out, "    new: %s\n", textValue(c.New))
+	}
+
+	switch {
+	case c.Change == ChangeModified && c.OldModPolicy != c.NewModPolicy:
+		fmt.Fprintf(out, "    mod_policy: %s -> %s\n", c.OldModPolicy, c.NewModPolicy)
+	case c.Change == ChangeAdded && c.ElementType == ConfigElementGroup:
+		fmt.Fprintf(out, "    mod_policy: %s\n", c.NewModPolicy)
+	}
+}
+
+func textValue(value any) string {
+	if policy, ok := value.(*Policy); ok {
+		return policy.Type + " " + policy.Rule
+	}
+
+	result, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+
+	return string(result)
+}
+
+func (d *ConfigDiff) addGroupChanges(groupPath []string, original, updated *cb.ConfigGroup) error {
+	if change := groupChange(groupPath, original, updated); change != nil {
+		d.Changes = append(d.Changes, change)
+	}
+
+	if err := d.addValueChanges(groupPath, original.GetValues(), updated.GetValues()); err != nil {
+		return err
+	}
+
+	if err := d.addPolicyChanges(groupPath, original.GetPolicies(), updated.GetPolicies()); err != nil {
+		return err
+	}
+
+	for _, name := range unionKeys(original.GetGroups(), updated.GetGroups()) {
+		childPath := append(slices.Clone(groupPath), name)
+		if err := d.addGroupChanges(childPath, original.GetGroups()[name], updated.GetGroups()[name]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// groupChange returns the change to a config group itself, or nil if the group exists in both configurations with
+// the same mod_policy.
+func groupChange(groupPath []string, original, updated *cb.ConfigGroup) *ConfigChange {
+	result := &ConfigChange{
+		ElementType:  ConfigElementGroup,
+		Path:         groupPathString(groupPath),
+		OldModPolicy: original.GetModPolicy(),
+		NewModPolicy: updated.GetModPolicy(),
+	}
+
+	switch {
+	case original == nil:
+		result.Change = ChangeAdded
+	case updated == nil:
+		result.Change = ChangeRemoved
+	case original.GetModPolicy() != updated.GetModPolicy():
+		result.Change = ChangeModified
+	default:
+		return nil
+	}
+
+	return result
+}
+
+func (d *ConfigDiff) addValueChanges(groupPath []string, original, updated map[string]*cb.ConfigValue) error {
+	for _, name := range unionKeys(original, updated) {
+		originalValue := original[name]
+		updatedValue := updated[name]
+		if originalValue != nil && updatedValue != nil &&
+			bytes.Equal(originalValue.GetValue(), updatedValue.GetValue()) &&
+			originalValue.GetModPolicy() == updatedValue.GetModPolicy() {
+			continue
+		}
+
+		change, err := newElementChange(ConfigElementValue, groupPath, name, originalValue, updatedValue, func(value *cb.ConfigValue) (any, error) {
+			return decodeConfigValue(name, value.GetValue())
+		})
+		if err != nil {
+			return err
+		}
+		d.Changes = append(d.Changes, change)
+	}
+
+	return nil
+}
+
+func (d *ConfigDiff) addPolicyChanges(groupPath []string, original, updated map[string]*cb.ConfigPolicy) error {
+	for _, name := range unionKeys(original, updated) {
+		originalPolicy := original[name]
+		updatedPolicy := updated[name]
+		if originalPolicy != nil && updatedPolicy != nil &&
+			proto.Equal(originalPolicy.GetPolicy(), updatedPolicy.GetPolicy()) &&
+			originalPolicy.GetModPolicy() == updatedPolicy.GetModPolicy() {
+			continue
+		}
+
+		change, err := newElementChange(ConfigElementPolicy, groupPath, name, originalPolicy, updatedPolicy, func(policy *cb.ConfigPolicy) (any, error) {
+			return decodeConfigPolicy(policy.GetPolicy())
+		})
+		if err != nil {
+			return err
+		}
+		d.Changes = append(d.Changes, change)
+	}
+
+	return nil
+}
+
+type modPolicyElement interface {
+	comparable
+	GetModPolicy() string
+}
+
+func newElementChange[E modPolicyElement](
+	elementType string,
+	groupPath []string,
+	name string,
+	original, updated E,
+	decode func(E) (any, error),
+) (*ConfigChange, error) {
+	var zero E
+	result := &ConfigChange{
+		ElementType: elementType,
+		Path:        groupPathString(groupPath) + "/" + name,
+	}
+
+	switch {
+	case original == zero:
+		result.Change = ChangeAdded
+	case updated == zero:
+		result.Change = ChangeRemoved
+	default:
+		result.Change = ChangeModified
+	}
+
+	if original != zero {
+		decoded, err := decode(original)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", result.Path, err)
+		}
+		result.Old = decoded
+		result.OldModPolicy = original.GetModPolicy()
+	}
+
+	if updated != zero {
+		decoded, err := decode(updated)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", result.Path, err)
+		}
+		result.New = decoded
+		result.NewModPolicy = updated.GetModPolicy()
+	}
+
+	return result, nil
+}
+
+func unionKeys[V any](a, b map[string]V) []string {
+	result := sortedKeys(a)
+	for _, key := range sortedKeys(b) {
+		if _, ok := a[key]; !ok {
+			result = append(result, key)
+		}
+	}
+	slices.Sort(result)
+
+	return result
+}
+
+// decodeConfigValue decodes a config value into a JSON-compatible form, based on its key. Nested messages serialized
+// as bytes, such as consensus metadata and Fabric MSP configuration, are also decoded. Values with unrecognized keys
+// are returned as bytes.
+func decodeConfigValue(key string, value []byte) (any, error) {
+	newMessage, ok := configValueMessages[key]
+	if !ok {
+		return value, nil
+	}
+
+	message := newMessage()
+	if err := proto.Unmarshal(value, message); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s config value: %w", key, err)
+	}
+
+	result, err := protoToMap(message)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := decodeNestedFields(result, message); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// configValueMessages creates empty messages of the type stored for each config value key.
+var configValueMessages = map[string]func() proto.Message{
+	HashingAlgorithmKey:          func() proto.Message { return &cb.HashingAlgorithm{} },
+	BlockDataHashingStructureKey: func() proto.Message { return &cb.BlockDataHashingStructure{} },
+	OrdererAddressesKey:          func() proto.Message { return &cb.OrdererAddresses{} },
+	EndpointsKey:                 func() proto.Message { return &cb.OrdererAddresses{} },
+	icc.ConsortiumKey:            func() proto.Message { return &cb.Consortium{} },
+	CapabilitiesKey:              func() proto.Message { return &cb.Capabilities{} },
+	OrderersKey:                  func() proto.Message { return &cb.Orderers{} },
+	ConsensusTypeKey:             func() proto.Message { return &ab.ConsensusType{} },
+	BatchSizeKey:                 func() proto.Message { return &ab.BatchSize{} },
+	BatchTimeoutKey:              func() proto.Message { return &ab.BatchTimeout{} },
+	ChannelRestrictionsKey:       func() proto.Message { return &ab.ChannelRestrictions{} },
+	MSPKey:                       func() proto.Message { return &msp.MSPConfig{} },
+	ACLsKey:                      func() proto.Message { return &pb.ACLs{} },
+	AnchorPeersKey:               func() proto.Message { return &pb.AnchorPeers{} },
+}
+
+// decodeNestedFields replaces serialized messages within a decoded config value with their decoded form.
+func decodeNestedFields(result map[string]any, message proto.Message) error {
+	switch m := message.(type) {
+	case *ab.ConsensusType:
+		if len(m.GetMetadata()) == 0 {
+			return nil
+		}
+		switch m.GetType() {
+		case ConsensusTypeEtcdRaft:
+			return setDecodedField(result, "metadata", m.GetMetadata(), &etcdraft.ConfigMetadata{})
+		case ConsensusTypeBFT:
+			return setDecodedField(result, "metadata", m.GetMetadata(), &smartbft.Options{})
+		}
+
+	case *msp.MSPConfig:
+		if imsp.ProviderType(m.GetType()) == imsp.FABRIC {
+			return setDecodedField(result, "config", m.GetConfig(), &msp.FabricMSPConfig{})
+		}
+	}
+
+	return nil
+}
+
+// decodeConfigPolicy decodes a policy into a Policy where possible. Signature policies that cannot be expressed in
+// the policy DSL, such as the BFT BlockValidation policy, are decoded into a JSON-compatible form.
+func decodeConfigPolicy(policy *cb.Policy) (any, error) {
+	if result, err := DecodePolicy(policy); err == nil {
+		return result, nil
+	}
+
+	if cb.Policy_PolicyType(policy.GetType()) != cb.Policy_SIGNATURE {
+		return nil, fmt.Errorf("unsupported policy type: %s", cb.Policy_PolicyType(policy.GetType()))
+	}
+
+	envelope := &cb.SignaturePolicyEnvelope{}
+	if err := proto.Unmarshal(policy.GetValue(), envelope); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal signature policy: %w", err)
+	}
+
+	rule, err := protoToMap(envelope)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"type": SignaturePolicyType,
+		"rule": rule,
+	}, nil
+}
+
+func setDecodedField(result map[string]any, field string, value []byte, message proto.Message) error {
+	if err := proto.Unmarshal(value, message); err != nil {
+		return fmt.Errorf("failed to unmarshal %s: %w", field, err)
+	}
+
+	decoded, err := protoToMap(message)
+	if err != nil {
+		return err
+	}
+
+	result[field] = decoded
+	return nil
+}
+
+func protoToMap(message proto.Message) (map[string]any, error) {
+	jsonBytes, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(message)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %T: %w", message, err)
+	}
+
+	result := make(map[string]any)
+	if err := json.Unmarshal(jsonBytes, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %T: %w", message, err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,147 @@
+package channel_test
+
+import (
+	"encoding/json"
+
+	"github.com/hyperledger/fabric-admin-sdk/pkg/channel"
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func AssertDiffConfigUpdate(config *cb.Config, configUpdate *channel.ConfigUpdate, err error) *channel.ConfigDiff {
+	Expect(err).NotTo(HaveOccurred())
+
+	result, err := channel.DiffConfigUpdate(config, configUpdate)
+	Expect(err).NotTo(HaveOccurred())
+	return result
+}
+
+var _ = Describe("Diff", func() {
+	var config *cb.Config
+
+	BeforeEach(func() {
+		config = NewTestConfig()
+	})
+
+	It("Finds no changes between identical configs", func() {
+		diff, err := channel.DiffConfig(config, NewTestConfig())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(diff.Changes).To(BeEmpty())
+		Expect(diff.String()).To(BeEmpty())
+	})
+
+	It("Decodes modified anchor peers", func() {
+		configUpdate, err := channel.AddAnchorPeer("mychannel", config, "Org1MSP", &channel.AnchorPeer{Host: "peer1.org1.example.com", Port: 8051})
+		diff := AssertDiffConfigUpdate(config, configUpdate, err)
+
+		Expect(diff.Changes).To(HaveLen(1))
+		change := diff.Changes[0]
+		Expect(change.Change).To(Equal(channel.ChangeModified))
+		Expect(change.ElementType).To(Equal(channel.ConfigElementValue))
+		Expect(change.Path).To(Equal("/Channel/Application/Org1MSP/AnchorPeers"))
+		Expect(change.Old).To(Equal(map[string]any{
+			"anchor_peers": []any{
+				map[string]any{"host": "peer0.org1.example.com", "port": float64(7051)},
+			},
+		}))
+		Expect(change.New).To(Equal(map[string]any{
+			"anchor_peers": []any{
+				map[string]any{"host": "peer0.org1.example.com", "port": float64(7051)},
+				map[string]any{"host": "peer1.org1.example.com", "port": float64(8051)},
+			},
+		}))
+	})
+
+	It("Decodes modified batch size", func() {
+		configUpdate, err := channel.UpdateBatchSettings("mychannel", config, &channel.BatchSettings{
+			BatchSize: &channel.BatchSize{
+				MaxMessageCount:   100,
+				AbsoluteMaxBytes:  4 * 1024 * 1024,
+				PreferredMaxBytes: 1024 * 1024,
+			},
+		})
+		diff := AssertDiffConfigUpdate(config, configUpdate, err)
+
+		Expect(diff.Changes).To(HaveLen(1))
+		Expect(diff.Changes[0].Path).To(Equal("/Channel/Orderer/BatchSize"))
+		Expect(diff.Changes[0].New).To(HaveKeyWithValue("max_message_count", float64(100)))
+	})
+
+	It("Decodes consenters in consensus metadata", func() {
+		configUpdate, err := channel.AddConsenter("mychannel", config, &channel.Consenter{
+			Host:          "orderer2.example.com",
+			Port:          7050,
+			ClientTLSCert: []byte("client"),
+			ServerTLSCert: []byte("server"),
+		})
+		diff := AssertDiffConfigUpdate(config, configUpdate, err)
+
+		Expect(diff.Changes).To(HaveLen(1))
+		Expect(diff.Changes[0].Path).To(Equal("/Channel/Orderer/ConsensusType"))
+
+		metadata := diff.Changes[0].New.(map[string]any)["metadata"].(map[string]any)
+		Expect(metadata["consenters"]).To(HaveLen(2))
+	})
+
+	It("Lists elements of removed organization", func() {
+		configUpdate, err := channel.RemoveApplicationOrganization("mychannel", config, "Org2MSP")
+		diff := AssertDiffConfigUpdate(config, configUpdate, err)
+
+		Expect(diff.Changes).To(ContainElement(&channel.ConfigChange{
+			Change:       channel.ChangeRemoved,
+			ElementType:  channel.ConfigElementGroup,
+			Path:         "/Channel/Application/Org2MSP",
+			OldModPolicy: channel.AdminsPolicyKey,
+		}))
+		Expect(diff.Changes).To(ContainElement(&channel.ConfigChange{
+			Change:       channel.ChangeRemoved,
+			ElementType:  channel.ConfigElementPolicy,
+			Path:         "/Channel/Application/Org2MSP/Admins",
+			Old:          &channel.Policy{Type: channel.SignaturePolicyType, Rule: "AND('Org2MSP.admin')"},
+			OldModPolicy: channel.AdminsPolicyKey,
+		}))
+		for _, change := range diff.Changes {
+			Expect(change.Change).To(Equal(channel.ChangeRemoved))
+			Expect(change.Path).To(HavePrefix("/Channel/Application/Org2MSP"))
+		}
+	})
+
+	It("Decodes modified policy", func() {
+		configUpdate, err := channel.SetPolicy("mychannel", config, "/Channel/Application/Admins", &channel.Policy{
+			Type: channel.ImplicitMetaPolicyType,
+			Rule: "ANY Admins",
+		})
+		diff := AssertDiffConfigUpdate(config, configUpdate, err)
+
+		Expect(diff.Changes).To(Equal([]*channel.ConfigChange{
+			{
+				Change:       channel.ChangeModified,
+				ElementType:  channel.ConfigElementPolicy,
+				Path:         "/Channel/Application/Admins",
+				Old:          &channel.Policy{Type: channel.ImplicitMetaPolicyType, Rule: "MAJORITY Admins"},
+				New:          &channel.Policy{Type: channel.ImplicitMetaPolicyType, Rule: "ANY Admins"},
+				OldModPolicy: channel.AdminsPolicyKey,
+				NewModPolicy: channel.AdminsPolicyKey,
+			},
+		}))
+
+		Expect(diff.String()).To(Equal(
+			"~ Policy /Channel/Application/Admins\n" +
+				"    old: ImplicitMeta MAJORITY Admins\n" +
+				"    new: ImplicitMeta ANY Admins\n",
+		))
+	})
+
+	It("Renders as JSON", func() {
+		configUpdate, err := channel.AddAnchorPeer("mychannel", config, "Org1MSP", &channel.AnchorPeer{Host: "peer1.org1.example.com", Port: 8051})
+		diff := AssertDiffConfigUpdate(config, configUpdate, err)
+
+		jsonBytes, err := diff.JSON()
+		Expect(err).NotTo(HaveOccurred())
+
+		var actual map[string]any
+		Expect(json.Unmarshal(jsonBytes, &actual)).To(Succeed())
+		Expect(actual["changes"]).To(ConsistOf(HaveKeyWithValue("path", "/Channel/Application/Org1MSP/AnchorPeers")))
+	})
+})
@@ -0,0 +1,395 @@
+package channel
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hyperledger/fabric-admin-sdk/internal/protoutil"
+	"github.com/hyperledger/fabric-admin-sdk/internal/util"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"github.com/hyperledger/fabric-protos-go-apiv2/msp"
+	"google.golang.org/protobuf/proto"
+)
+
+// Types of element within channel configuration.
+const (
+	ConfigElementGroup  = "Group"
+	ConfigElementValue  = "Value"
+	ConfigElementPolicy = "Policy"
+)
+
+// ElementCheck is the result of evaluating the mod_policy of a config element changed by a config update against
+// the signatures collected so far.
+type ElementCheck struct {
+	// ElementType is the type of config element, such as ConfigElementValue.
+	ElementType string
+
+	// Path of the config element, for example "/Channel/Application/Org1MSP/AnchorPeers".
+	Path string
+
+	// ModPolicy is the absolute path of the policy governing modification of the element.
+	ModPolicy string
+
+	// Satisfied is true if the collected signatures satisfy the mod_policy.
+	Satisfied bool
+
+	// MissingSigners describes the principals, such as "Org2MSP.admin", whose signatures would help to satisfy the
+	// mod_policy. It is empty if the mod_policy is satisfied.
+	MissingSigners []string
+}
+
+// CheckSignatures evaluates whether the signatures collected so far satisfy the mod_policy of each existing config
+// element changed by the config update. Policies are evaluated using the MSPs and policies in the current channel
+// configuration. Elements added by the config update are governed by the mod_policy of their parent group, which is
+// also changed by the update, so they are not reported separately.
+func (u *ConfigUpdate) CheckSignatures(current *cb.Config) ([]*ElementCheck, error) {
+	configUpdate, err := u.ConfigUpdate()
+	if err != nil {
+		return nil, err
+	}
+
+	evaluator, err := newPolicyEvaluator(current)
+	if err != nil {
+		return nil, err
+	}
+
+	signatures, err := configSignedData(u.envelope)
+	if err != nil {
+		return nil, err
+	}
+	identities := validIdentities(signatures, evaluator.msps)
+
+	existing := configElements(current.GetChannelGroup())
+
+	var result []*ElementCheck
+	for _, element := range configElements(configUpdate.GetWriteSet()) {
+		previous, ok := existing[element.key()]
+		if !ok || previous.version == element.version {
+			continue
+		}
+
+		modPolicy := previous.modPolicyPath()
+		check := &ElementCheck{
+			ElementType: previous.elementType,
+			Path:        previous.path(),
+			ModPolicy:   modPolicy,
+		}
+
+		groupPath, policyName, err := splitAbsolutePolicyPath(modPolicy)
+		if err != nil {
+			check.MissingSigners = []string{err.Error()}
+		} else {
+			check.Satisfied, check.MissingSigners = evaluator.evaluate(groupPath, policyName, identities)
+		}
+
+		result = append(result, check)
+	}
+
+	slices.SortFunc(result, func(a, b *ElementCheck) int {
+		return strings.Compare(a.Path+a.ElementType, b.Path+b.ElementType)
+	})
+
+	return result, nil
+}
+
+func configSignedData(envelope *cb.ConfigUpdateEnvelope) ([]*signedData, error) {
+	var result []*signedData
+	for _, signature := range envelope.GetSignatures() {
+		signatureHeader, err := protoutil.UnmarshalSignatureHeader(signature.GetSignatureHeader())
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, &signedData{
+			data:      util.Concatenate(signature.GetSignatureHeader(), envelope.GetConfigUpdate()),
+			identity:  signatureHeader.GetCreator(),
+			signature: signature.GetSignature(),
+		})
+	}
+
+	return result, nil
+}
+
+// policyEvaluator evaluates signature and implicit meta policies within a channel configuration.
+type policyEvaluator struct {
+	channelGroup *cb.ConfigGroup
+	msps         map[string]*mspVerifier
+}
+
+func newPolicyEvaluator(config *cb.Config) (*policyEvaluator, error) {
+	msps, err := mspVerifiers(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &policyEvaluator{
+		channelGroup: config.GetChannelGroup(),
+		msps:         msps,
+	}, nil
+}
+
+// evaluate reports whether the identities satisfy the named policy in the config group at the specified path below
+// the channel group. If the policy is not satisfied, principals that could help to satisfy it are also returned.
+func (e *policyEvaluator) evaluate(groupPath []string, policyName string, identities []*validIdentity) (bool, []string) {
+	group, err := findGroup(e.channelGroup, groupPath...)
+	if err != nil {
+		return false, []string{err.Error()}
+	}
+
+	configPolicy, ok := group.GetPolicies()[policyName]
+	if !ok {
+		return false, []string{fmt.Sprintf("policy %s/%s does not exist", groupPathString(groupPath), policyName)}
+	}
+
+	policy := configPolicy.GetPolicy()
+	switch cb.Policy_PolicyType(policy.GetType()) {
+	case cb.Policy_SIGNATURE:
+		envelope := &cb.SignaturePolicyEnvelope{}
+		if err := proto.Unmarshal(policy.GetValue(), envelope); err != nil {
+			return false, []string{fmt.Sprintf("invalid signature policy: %v", err)}
+		}
+		return evaluateSignaturePolicy(envelope, identities)
+
+	case cb.Policy_IMPLICIT_META:
+		implicitMeta := &cb.ImplicitMetaPolicy{}
+		if err := proto.Unmarshal(policy.GetValue(), implicitMeta); err != nil {
+			return false, []string{fmt.Sprintf("invalid implicit meta policy: %v", err)}
+		}
+		return e.evaluateImplicitMeta(group, groupPath, implicitMeta, identities)
+
+	default:
+		return false, []string{fmt.Sprintf("unsupported policy type: %s", cb.Policy_PolicyType(policy.GetType()))}
+	}
+}
+
+func (e *policyEvaluator) evaluateImplicitMeta(
+	group *cb.ConfigGroup,
+	groupPath []string,
+	policy *cb.ImplicitMetaPolicy,
+	identities []*validIdentity,
+) (bool, []string) {
+	childNames := sortedKeys(group.GetGroups())
+
+	var threshold int
+	switch policy.GetRule() {
+	case cb.ImplicitMetaPolicy_ANY:
+		threshold = 1
+	case cb.ImplicitMetaPolicy_ALL:
+		threshold = len(childNames)
+	case cb.ImplicitMetaPolicy_MAJORITY:
+		threshold = len(childNames)/2 + 1
+	}
+
+	// As in Fabric, an implicit meta policy over a group with no sub-groups is always satisfied.
+	if len(childNames) == 0 {
+		threshold = 0
+	}
+
+	var satisfied int
+	var missing []string
+	for _, childName := range childNames {
+		childPath := append(slices.Clone(groupPath), childName)
+		ok, childMissing := e.evaluate(childPath, policy.GetSubPolicy(), identities)
+		if ok {
+			satisfied++
+		} else {
+			missing = appendUnique(missing, childMissing...)
+		}
+	}
+
+	if satisfied >= threshold {
+		return true, nil
+	}
+
+	return false, missing
+}
+
+// evaluateSignaturePolicy evaluates a signature policy in the same way as Fabric, where each identity can satisfy at
+// most one principal.
+func evaluateSignaturePolicy(envelope *cb.SignaturePolicyEnvelope, identities []*validIdentity) (bool, []string) {
+	used := make([]bool, len(identities))
+	return evaluateSignatureRule(envelope.GetRule(), envelope.GetIdentities(), identities, used)
+}
+
+func evaluateSignatureRule(
+	rule *cb.SignaturePolicy,
+	principals []*msp.MSPPrincipal,
+	identities []*validIdentity,
+	used []bool,
+) (bool, []string) {
+	switch rule.GetType().(type) {
+	case *cb.SignaturePolicy_SignedBy:
+		return evaluateSignedBy(rule.GetSignedBy(), principals, identities, used)
+	case *cb.SignaturePolicy_NOutOf_:
+		return evaluateNOutOf(rule.GetNOutOf(), principals, identities, used)
+	default:
+		return false, []string{"invalid signature policy rule"}
+	}
+}
+
+func evaluateSignedBy(index int32, principals []*msp.MSPPrincipal, identities []*validIdentity, used []bool) (bool, []string) {
+	if index < 0 || int(index) >= len(principals) {
+		return false, []string{fmt.Sprintf("invalid principal index: %d", index)}
+	}
+
+	principal := principals[index]
+	for i, id := range identities {
+		if !used[i] && id.satisfies(principal) {
+			used[i] = true
+			return true, nil
+		}
+	}
+
+	return false, []string{principalString(principal)}
+}
+
+func evaluateNOutOf(
+	rule *cb.SignaturePolicy_NOutOf,
+	principals []*msp.MSPPrincipal,
+	identities []*validIdentity,
+	used []bool,
+) (bool, []string) {
+	var satisfied int32
+	var missing []string
+	candidate := make([]bool, len(used))
+	for _, child := range rule.GetRules() {
+		copy(candidate, used)
+		ok, childMissing := evaluateSignatureRule(child, principals, identities, candidate)
+		if ok {
+			satisfied++
+			copy(used, candidate)
+		} else {
+			missing = appendUnique(missing, childMissing...)
+		}
+	}
+
+	if satisfied >= rule.GetN() {
+		return true, nil
+	}
+
+	return false, missing
+}
+
+func principalString(principal *msp.MSPPrincipal) string {
+	switch principal.GetPrincipalClassification() {
+	case msp.MSPPrincipal_ROLE:
+		role := &msp.MSPRole{}
+		if err := proto.Unmarshal(principal.GetPrincipal(), role); err != nil {
+			return "invalid role principal"
+		}
+		return role.GetMspIdentifier() + "." + strings.ToLower(role.GetRole().String())
+
+	case msp.MSPPrincipal_IDENTITY:
+		id := &msp.SerializedIdentity{}
+		if err := proto.Unmarshal(principal.GetPrincipal(), id); err != nil {
+			return "invalid identity principal"
+		}
+		if certificate, err := parseCertificate(id.GetIdBytes()); err == nil {
+			return id.GetMspid() + " identity " + certificate.Subject.CommonName
+		}
+		return id.GetMspid() + " identity"
+
+	default:
+		return principal.GetPrincipalClassification().String() + " principal"
+	}
+}
+
+func appendUnique(values []string, additions ...string) []string {
+	for _, addition := range additions {
+		if !slices.Contains(values, addition) {
+			values = append(values, addition)
+		}
+	}
+
+	return values
+}
+
+// configElement is a group, value or policy within channel configuration.
+type configElement struct {
+	elementType string
+	groupPath   []string
+	name        string
+	version     uint64
+	modPolicy   string
+}
+
+// configElements returns all the elements within a channel group, keyed by element type and path.
+func configElements(channelGroup *cb.ConfigGroup) map[string]*configElement {
+	result := make(map[string]*configElement)
+	addConfigElements(result, nil, channelGroup)
+	return result
+}
+
+func addConfigElements(elements map[string]*configElement, groupPath []string, group *cb.ConfigGroup) {
+	var parentPath []string
+	var name string
+	if len(groupPath) > 0 {
+		parentPath = groupPath[:len(groupPath)-1]
+		name = groupPath[len(groupPath)-1]
+	}
+
+	groupElement := &configElement{
+		elementType: ConfigElementGroup,
+		groupPath:   parentPath,
+		name:        name,
+		version:     group.GetVersion(),
+		modPolicy:   group.GetModPolicy(),
+	}
+	elements[groupElement.key()] = groupElement
+
+	for valueName, value := range group.GetValues() {
+		element := &configElement{
+			elementType: ConfigElementValue,
+			groupPath:   groupPath,
+			name:        valueName,
+			version:     value.GetVersion(),
+			modPolicy:   value.GetModPolicy(),
+		}
+		elements[element.key()] = element
+	}
+
+	for policyName, policy := range group.GetPolicies() {
+		element := &configElement{
+			elementType: ConfigElementPolicy,
+			groupPath:   groupPath,
+			name:        policyName,
+			version:     policy.GetVersion(),
+			modPolicy:   policy.GetModPolicy(),
+		}
+		elements[element.key()] = element
+	}
+
+	for childName, child := range group.GetGroups() {
+		addConfigElements(elements, append(slices.Clone(groupPath), childName), child)
+	}
+}
+
+func (e *configElement) key() string {
+	return e.elementType + " " + e.path()
+}
+
+// path returns the absolute path of the element, for example "/Channel/Application/Org1MSP".
+func (e *configElement) path() string {
+	if e.elementType == ConfigElementGroup && e.name == "" {
+		return groupPathString(nil)
+	}
+
+	return groupPathString(e.groupPath) + "/" + e.name
+}
+
+// modPolicyPath resolves the element's mod_policy to an absolute policy path. Relative mod_policy names refer to a
+// policy in the group itself for groups, or in the containing group for values and policies.
+func (e *configElement) modPolicyPath() string {
+	if strings.HasPrefix(e.modPolicy, "/") {
+		return e.modPolicy
+	}
+
+	groupPath := e.groupPath
+	if e.elementType == ConfigElementGroup && e.name != "" {
+		groupPath = append(slices.Clone(groupPath), e.name)
+	}
+
+	return groupPathString(groupPath) + "/" + e.modPolicy
+}
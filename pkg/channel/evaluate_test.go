@@ -0,0 +1,137 @@
+package channel_test
+
+import (
+	"path/filepath"
+
+	"github.com/hyperledger/fabric-admin-sdk/pkg/channel"
+	"github.com/hyperledger/fabric-admin-sdk/pkg/identity"
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func NewTestOrgAdmin(org string, mspID string) identity.SigningIdentity {
+	mspDir := filepath.Join(testDataDir, "peerOrganizations", org, "users", "Admin@"+org, "msp")
+
+	certificate, err := identity.ReadCertificate(filepath.Join(mspDir, "signcerts", "Admin@"+org+"-cert.pem"))
+	Expect(err).NotTo(HaveOccurred())
+
+	privateKey, err := identity.ReadPrivateKey(filepath.Join(mspDir, "keystore", "priv_sk"))
+	Expect(err).NotTo(HaveOccurred())
+
+	result, err := identity.NewPrivateKeySigningIdentity(mspID, certificate, privateKey)
+	Expect(err).NotTo(HaveOccurred())
+	return result
+}
+
+var _ = Describe("CheckSignatures", func() {
+	var config *cb.Config
+	var org1Admin, org2Admin identity.SigningIdentity
+
+	BeforeEach(func() {
+		config = NewTestConfig()
+		org1Admin = NewTestOrgAdmin("org1.example.com", "Org1MSP")
+		org2Admin = NewTestOrgAdmin("org2.example.com", "Org2MSP")
+	})
+
+	Context("Organization update", func() {
+		var configUpdate *channel.ConfigUpdate
+
+		BeforeEach(func() {
+			var err error
+			configUpdate, err = channel.AddAnchorPeer("mychannel", config, "Org1MSP", &channel.AnchorPeer{Host: "peer1.org1.example.com", Port: 8051})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Reports missing signers without signatures", func() {
+			checks, err := configUpdate.CheckSignatures(config)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(checks).To(HaveLen(1))
+			Expect(checks[0]).To(Equal(&channel.ElementCheck{
+				ElementType:    channel.ConfigElementValue,
+				Path:           "/Channel/Application/Org1MSP/AnchorPeers",
+				ModPolicy:      "/Channel/Application/Org1MSP/Admins",
+				Satisfied:      false,
+				MissingSigners: []string{"Org1MSP.admin"},
+			}))
+		})
+
+		It("Is satisfied by organization admin", func() {
+			Expect(configUpdate.Sign(org1Admin)).To(Succeed())
+
+			checks, err := configUpdate.CheckSignatures(config)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(checks).To(HaveLen(1))
+			Expect(checks[0].Satisfied).To(BeTrue())
+			Expect(checks[0].MissingSigners).To(BeEmpty())
+		})
+
+		It("Is not satisfied by another organization's admin", func() {
+			Expect(configUpdate.Sign(org2Admin)).To(Succeed())
+
+			checks, err := configUpdate.CheckSignatures(config)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(checks[0].Satisfied).To(BeFalse())
+		})
+
+		It("Ignores identity not issued by organization MSP", func() {
+			untrusted, _ := NewSigningIdentity("Org1MSP")
+			Expect(configUpdate.Sign(untrusted)).To(Succeed())
+
+			checks, err := configUpdate.CheckSignatures(config)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(checks[0].Satisfied).To(BeFalse())
+		})
+	})
+
+	Context("Application update", func() {
+		var configUpdate *channel.ConfigUpdate
+
+		BeforeEach(func() {
+			var err error
+			configUpdate, err = channel.SetACL("mychannel", config, "qscc/GetBlockByNumber", "/Channel/Application/Writers")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Requires majority of organization admins", func() {
+			Expect(configUpdate.Sign(org1Admin)).To(Succeed())
+
+			checks, err := configUpdate.CheckSignatures(config)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(checks).To(HaveLen(1))
+			Expect(checks[0].Path).To(Equal("/Channel/Application"))
+			Expect(checks[0].ModPolicy).To(Equal("/Channel/Application/Admins"))
+			Expect(checks[0].Satisfied).To(BeFalse())
+			Expect(checks[0].MissingSigners).To(Equal([]string{"Org2MSP.admin"}))
+		})
+
+		It("Is satisfied by majority of organization admins", func() {
+			Expect(configUpdate.Sign(org1Admin)).To(Succeed())
+			Expect(configUpdate.Sign(org2Admin)).To(Succeed())
+
+			checks, err := configUpdate.CheckSignatures(config)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(checks[0].Satisfied).To(BeTrue())
+		})
+	})
+
+	Context("Update of group without sub-groups", func() {
+		It("Is satisfied without signatures", func() {
+			config.GetChannelGroup().GetGroups()["Application"].Groups = nil
+
+			configUpdate, err := channel.SetACL("mychannel", config, "qscc/GetBlockByNumber", "/Channel/Application/Writers")
+			Expect(err).NotTo(HaveOccurred())
+
+			checks, err := configUpdate.CheckSignatures(config)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(checks).To(HaveLen(1))
+			Expect(checks[0].ModPolicy).To(Equal("/Channel/Application/Admins"))
+			Expect(checks[0].Satisfied).To(BeTrue())
+			Expect(checks[0].MissingSigners).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,584 @@
+package channel
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	icc "github.com/hyperledger/fabric-admin-sdk/internal/channelconfig"
+	"github.com/hyperledger/fabric-admin-sdk/internal/configtxgen/encoder"
+	"github.com/hyperledger/fabric-admin-sdk/internal/configtxgen/genesisconfig"
+	"github.com/hyperledger/fabric-admin-sdk/internal/genesis"
+	imsp "github.com/hyperledger/fabric-admin-sdk/internal/msp"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"github.com/hyperledger/fabric-protos-go-apiv2/msp"
+	"github.com/hyperledger/fabric-protos-go-apiv2/orderer/etcdraft"
+	"github.com/hyperledger/fabric-protos-go-apiv2/orderer/smartbft"
+	"google.golang.org/protobuf/proto"
+)
+
+// Consensus types supported by application channels.
+const (
+	ConsensusTypeEtcdRaft = "etcdraft"
+	ConsensusTypeBFT      = "BFT"
+)
+
+// Policy types used in channel configuration policies.
+const (
+	SignaturePolicyType    = encoder.SignaturePolicyType
+	ImplicitMetaPolicyType = encoder.ImplicitMetaPolicyType
+)
+
+const (
+	defaultBatchTimeout      = 2 * time.Second
+	defaultMaxMessageCount   = 500
+	defaultAbsoluteMaxBytes  = 10 * 1024 * 1024
+	defaultPreferredMaxBytes = 2 * 1024 * 1024
+)
+
+// Policy defines a channel configuration policy.
+type Policy struct {
+	// Type of the policy, either SignaturePolicyType or ImplicitMetaPolicyType.
+	Type string `json:"type"`
+
+	// Rule expressing the policy, for example "OR('Org1MSP.admin')" for a signature policy, or "MAJORITY Admins" for
+	// an implicit meta policy.
+	Rule string `json:"rule"`
+}
+
+// AnchorPeer used by peers in other organizations for cross-organization gossip communication.
+type AnchorPeer struct {
+	Host string
+	Port int32
+}
+
+// Organization participating in a channel, either as an application organization or as an orderer organization.
+type Organization struct {
+	// Name of the organization's config group. Defaults to the MSP ID if not specified.
+	Name string
+
+	// MSPID of the organization's Membership Service Provider.
+	MSPID string
+
+	// MSPDir from which the MSP definition is loaded. Ignored if MSP is specified.
+	MSPDir string
+
+	// MSPType of the MSP definition loaded from MSPDir, either "bccsp" or "idemix". Defaults to "bccsp".
+	MSPType string
+
+	// MSP definition held in memory, used in place of loading the MSP definition from MSPDir.
+	MSP *msp.MSPConfig
+
+	// Policies for the organization. Default policies based on the MSP ID are used if not specified.
+	Policies map[string]*Policy
+
+	// AnchorPeers for an application organization.
+	AnchorPeers []*AnchorPeer
+
+	// OrdererEndpoints for an orderer organization.
+	OrdererEndpoints []string
+}
+
+// Consenter is an ordering service node participating in consensus.
+type Consenter struct {
+	// ID of the consenter. Required only for BFT consensus.
+	ID uint32
+
+	Host string
+	Port uint32
+
+	// MSPID of the consenter. Required only for BFT consensus.
+	MSPID string
+
+	// Identity is the PEM encoded enrollment certificate of the consenter. Required only for BFT consensus.
+	Identity []byte
+
+	// ClientTLSCert is the PEM encoded TLS client certificate of the consenter.
+	ClientTLSCert []byte
+
+	// ServerTLSCert is the PEM encoded TLS server certificate of the consenter.
+	ServerTLSCert []byte
+}
+
+// BatchSize controls the number of messages batched into a block.
+type BatchSize struct {
+	MaxMessageCount   uint32
+	AbsoluteMaxBytes  uint32
+	PreferredMaxBytes uint32
+}
+
+// OrdererConfig is the ordering service configuration for a channel.
+type OrdererConfig struct {
+	// OrdererType is the consensus type, either ConsensusTypeEtcdRaft or ConsensusTypeBFT.
+	OrdererType string
+
+	// Addresses of ordering service nodes. Deprecated in favor of organization OrdererEndpoints.
+	Addresses []string
+
+	// BatchTimeout is the amount of time to wait before creating a batch. Defaults to 2 seconds.
+	BatchTimeout time.Duration
+
+	// BatchSize controls the number of messages batched into a block. Zero values are replaced by defaults.
+	BatchSize BatchSize
+
+	// Consenters participating in consensus.
+	Consenters []*Consenter
+
+	// EtcdRaftOptions used with etcdraft consensus. Defaults are used if not specified.
+	EtcdRaftOptions *etcdraft.Options
+
+	// SmartBFTOptions used with BFT consensus. Defaults are used if not specified.
+	SmartBFTOptions *smartbft.Options
+
+	// Organizations operating ordering service nodes.
+	Organizations []*Organization
+
+	MaxChannels  uint64
+	Capabilities []string
+
+	// Policies for the orderer group. Default policies are used if not specified.
+	Policies map[string]*Policy
+}
+
+// ApplicationConfig is the application configuration for a channel.
+type ApplicationConfig struct {
+	// Organizations whose peers participate in the channel.
+	Organizations []*Organization
+
+	Capabilities []string
+
+	// Policies for the application group. Default policies are used if not specified.
+	Policies map[string]*Policy
+
+	// ACLs mapping API resources to policies. The peer defaults are used for any resources not specified.
+	ACLs map[string]string
+}
+
+// GenesisConfig is the initial configuration of a channel.
+type GenesisConfig struct {
+	Orderer     *OrdererConfig
+	Application *ApplicationConfig
+
+	Capabilities []string
+
+	// Policies for the channel group. Default policies are used if not specified.
+	Policies map[string]*Policy
+}
+
+// NewGenesisBlock creates the genesis block for a new channel, which can be used with CreateChannel. Unlike the
+// configtxgen tool, all configuration is supplied programmatically and MSP definitions and certificates may be held
+// in memory instead of on the file system.
+func NewGenesisBlock(channelID string, config *GenesisConfig) (*cb.Block, error) {
+	if channelID == "" {
+		return nil, errors.New("channel ID is required")
+	}
+
+	channelGroup, err := newChannelGroup(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return genesis.NewFactoryImpl(channelGroup).Block(channelID), nil
+}
+
+func newChannelGroup(config *GenesisConfig) (*cb.ConfigGroup, error) {
+	if config.Orderer == nil {
+		return nil, errors.New("orderer configuration is required")
+	}
+	if config.Application == nil {
+		return nil, errors.New("application configuration is required")
+	}
+
+	channelGroup := encoder.NewConfigGroup()
+	if err := encoder.AddPolicies(channelGroup, policiesToGenesis(policiesOrDefault(config.Policies, defaultGroupPolicies())), icc.AdminsPolicyKey); err != nil {
+		return nil, fmt.Errorf("failed to add policies to channel group: %w", err)
+	}
+
+	setValue(channelGroup, icc.HashingAlgorithmValue(), icc.AdminsPolicyKey)
+	setValue(channelGroup, icc.BlockDataHashingStructureValue(), icc.AdminsPolicyKey)
+	if len(config.Orderer.Addresses) > 0 {
+		setValue(channelGroup, icc.OrdererAddressesValue(config.Orderer.Addresses), encoder.OrdererAdminsPolicy)
+	}
+	if len(config.Capabilities) > 0 {
+		setValue(channelGroup, icc.CapabilitiesValue(capabilitiesMap(config.Capabilities)), icc.AdminsPolicyKey)
+	}
+
+	ordererGroup, err := newOrdererGroup(config.Orderer)
+	if err != nil {
+		return nil, err
+	}
+	channelGroup.Groups[icc.OrdererGroupKey] = ordererGroup
+
+	applicationGroup, err := newApplicationGroup(config.Application)
+	if err != nil {
+		return nil, err
+	}
+	channelGroup.Groups[icc.ApplicationGroupKey] = applicationGroup
+
+	channelGroup.ModPolicy = icc.AdminsPolicyKey
+	return channelGroup, nil
+}
+
+func newOrdererGroup(config *OrdererConfig) (*cb.ConfigGroup, error) {
+	consensusMetadata, consenters, err := consensusMetadata(config)
+	if err != nil {
+		return nil, err
+	}
+
+	organizations, err := orgsToGenesis(config.Organizations, defaultOrdererOrgPolicies)
+	if err != nil {
+		return nil, err
+	}
+
+	batchTimeout := config.BatchTimeout
+	if batchTimeout == 0 {
+		batchTimeout = defaultBatchTimeout
+	}
+
+	genesisOrderer := &genesisconfig.Orderer{
+		OrdererType:  config.OrdererType,
+		Addresses:    config.Addresses,
+		BatchTimeout: batchTimeout,
+		BatchSize: genesisconfig.BatchSize{
+			MaxMessageCount:   valueOrDefault(config.BatchSize.MaxMessageCount, defaultMaxMessageCount),
+			AbsoluteMaxBytes:  valueOrDefault(config.BatchSize.AbsoluteMaxBytes, defaultAbsoluteMaxBytes),
+			PreferredMaxBytes: valueOrDefault(config.BatchSize.PreferredMaxBytes, defaultPreferredMaxBytes),
+		},
+		Organizations: organizations,
+		MaxChannels:   config.MaxChannels,
+		Capabilities:  capabilitiesMap(config.Capabilities),
+		Policies:      policiesToGenesis(policiesOrDefault(config.Policies, defaultOrdererPolicies())),
+	}
+
+	ordererGroup, err := encoder.NewOrdererGroupWithConsensus(genesisOrderer, consensusMetadata, consenters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create orderer group: %w", err)
+	}
+
+	return ordererGroup, nil
+}
+
+func consensusMetadata(config *OrdererConfig) ([]byte, []*cb.Consenter, error) {
+	if len(config.Consenters) == 0 {
+		return nil, nil, errors.New("no consenters specified")
+	}
+
+	switch config.OrdererType {
+	case ConsensusTypeEtcdRaft:
+		consenters, err := etcdRaftConsenters(config.Consenters)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		options := config.EtcdRaftOptions
+		if options == nil {
+			options = defaultEtcdRaftOptions()
+		}
+
+		metadata, err := proto.Marshal(&etcdraft.ConfigMetadata{
+			Consenters: consenters,
+			Options:    options,
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to marshal etcdraft metadata: %w", err)
+		}
+
+		return metadata, nil, nil
+
+	case ConsensusTypeBFT:
+		consenters, err := bftConsenters(config.Consenters)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		options := config.SmartBFTOptions
+		if options == nil {
+			options = defaultSmartBFTOptions()
+		}
+
+		metadata, err := icc.MarshalBFTOptions(options)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to marshal BFT options: %w", err)
+		}
+
+		return metadata, consenters, nil
+
+	default:
+		return nil, nil, fmt.Errorf("unsupported orderer type: %s", config.OrdererType)
+	}
+}
+
+func etcdRaftConsenters(consenters []*Consenter) ([]*etcdraft.Consenter, error) {
+	var result []*etcdraft.Consenter
+	for _, consenter := range consenters {
+		if err := consenter.validate(); err != nil {
+			return nil, err
+		}
+
+		result = append(result, &etcdraft.Consenter{
+			Host:          consenter.Host,
+			Port:          consenter.Port,
+			ClientTlsCert: consenter.ClientTLSCert,
+			ServerTlsCert: consenter.ServerTLSCert,
+		})
+	}
+
+	return result, nil
+}
+
+func bftConsenters(consenters []*Consenter) ([]*cb.Consenter, error) {
+	ids := make(map[uint32]bool)
+
+	var result []*cb.Consenter
+	for _, consenter := range consenters {
+		if err := consenter.validate(); err != nil {
+			return nil, err
+		}
+		if err := consenter.validateBFT(); err != nil {
+			return nil, err
+		}
+		if ids[consenter.ID] {
+			return nil, fmt.Errorf("duplicate consenter ID: %d", consenter.ID)
+		}
+		ids[consenter.ID] = true
+
+		result = append(result, &cb.Consenter{
+			Id:            consenter.ID,
+			Host:          consenter.Host,
+			Port:          consenter.Port,
+			MspId:         consenter.MSPID,
+			Identity:      consenter.Identity,
+			ClientTlsCert: consenter.ClientTLSCert,
+			ServerTlsCert: consenter.ServerTLSCert,
+		})
+	}
+
+	return result, nil
+}
+
+func (c *Consenter) validate() error {
+	if c.Host == "" {
+		return errors.New("consenter host is required")
+	}
+	if c.Port == 0 {
+		return fmt.Errorf("consenter port is required for %s", c.Host)
+	}
+	if len(c.ClientTLSCert) == 0 {
+		return fmt.Errorf("consenter client TLS certificate is required for %s:%d", c.Host, c.Port)
+	}
+	if len(c.ServerTLSCert) == 0 {
+		return fmt.Errorf("consenter server TLS certificate is required for %s:%d", c.Host, c.Port)
+	}
+
+	return nil
+}
+
+func (c *Consenter) validateBFT() error {
+	if c.ID == 0 {
+		return fmt.Errorf("consenter ID is required for %s:%d", c.Host, c.Port)
+	}
+	if c.MSPID == "" {
+		return fmt.Errorf("consenter MSP ID is required for %s:%d", c.Host, c.Port)
+	}
+	if len(c.Identity) == 0 {
+		return fmt.Errorf("consenter identity is required for %s:%d", c.Host, c.Port)
+	}
+
+	return nil
+}
+
+func newApplicationGroup(config *ApplicationConfig) (*cb.ConfigGroup, error) {
+	organizations, err := orgsToGenesis(config.Organizations, defaultApplicationOrgPolicies)
+	if err != nil {
+		return nil, err
+	}
+
+	applicationGroup, err := encoder.NewApplicationGroup(&genesisconfig.Application{
+		Organizations: organizations,
+		Capabilities:  capabilitiesMap(config.Capabilities),
+		Policies:      policiesToGenesis(policiesOrDefault(config.Policies, defaultApplicationPolicies())),
+		ACLs:          config.ACLs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create application group: %w", err)
+	}
+
+	return applicationGroup, nil
+}
+
+func orgsToGenesis(organizations []*Organization, defaultPolicies func(mspID string) map[string]*Policy) ([]*genesisconfig.Organization, error) {
+	names := make(map[string]bool)
+
+	var result []*genesisconfig.Organization
+	for _, org := range organizations {
+		genesisOrg, err := org.toGenesis(defaultPolicies)
+		if err != nil {
+			return nil, err
+		}
+
+		if names[genesisOrg.Name] {
+			return nil, fmt.Errorf("duplicate organization name: %s", genesisOrg.Name)
+		}
+		names[genesisOrg.Name] = true
+
+		result = append(result, genesisOrg)
+	}
+
+	return result, nil
+}
+
+func (o *Organization) toGenesis(defaultPolicies func(mspID string) map[string]*Policy) (*genesisconfig.Organization, error) {
+	if o.MSPID == "" {
+		return nil, errors.New("organization MSP ID is required")
+	}
+	if o.MSP == nil && o.MSPDir == "" {
+		return nil, fmt.Errorf("either MSP or MSPDir is required for organization %s", o.MSPID)
+	}
+
+	var anchorPeers []*genesisconfig.AnchorPeer
+	for _, anchorPeer := range o.AnchorPeers {
+		anchorPeers = append(anchorPeers, &genesisconfig.AnchorPeer{
+			Host: anchorPeer.Host,
+			Port: anchorPeer.Port,
+		})
+	}
+
+	return &genesisconfig.Organization{
+		Name:             o.name(),
+		ID:               o.MSPID,
+		MSPDir:           o.MSPDir,
+		MSPType:          o.mspType(),
+		MSPConfig:        o.MSP,
+		Policies:         policiesToGenesis(policiesOrDefault(o.Policies, defaultPolicies(o.MSPID))),
+		AnchorPeers:      anchorPeers,
+		OrdererEndpoints: o.OrdererEndpoints,
+	}, nil
+}
+
+func (o *Organization) name() string {
+	if o.Name != "" {
+		return o.Name
+	}
+
+	return o.MSPID
+}
+
+func (o *Organization) mspType() string {
+	if o.MSPType != "" {
+		return o.MSPType
+	}
+
+	return imsp.ProviderTypeToString(imsp.FABRIC)
+}
+
+func policiesToGenesis(policies map[string]*Policy) map[string]*genesisconfig.Policy {
+	result := make(map[string]*genesisconfig.Policy, len(policies))
+	for name, policy := range policies {
+		result[name] = &genesisconfig.Policy{
+			Type: policy.Type,
+			Rule: policy.Rule,
+		}
+	}
+
+	return result
+}
+
+func policiesOrDefault(policies map[string]*Policy, defaults map[string]*Policy) map[string]*Policy {
+	if len(policies) > 0 {
+		return policies
+	}
+
+	return defaults
+}
+
+func capabilitiesMap(capabilities []string) map[string]bool {
+	result := make(map[string]bool, len(capabilities))
+	for _, capability := range capabilities {
+		result[capability] = true
+	}
+
+	return result
+}
+
+func valueOrDefault(value, defaultValue uint32) uint32 {
+	if value == 0 {
+		return defaultValue
+	}
+
+	return value
+}
+
+func implicitMetaPolicy(rule string) *Policy {
+	return &Policy{Type: ImplicitMetaPolicyType, Rule: rule}
+}
+
+func signaturePolicy(rule string) *Policy {
+	return &Policy{Type: SignaturePolicyType, Rule: rule}
+}
+
+func defaultGroupPolicies() map[string]*Policy {
+	return map[string]*Policy{
+		icc.ReadersPolicyKey: implicitMetaPolicy("ANY " + icc.ReadersPolicyKey),
+		icc.WritersPolicyKey: implicitMetaPolicy("ANY " + icc.WritersPolicyKey),
+		icc.AdminsPolicyKey:  implicitMetaPolicy("MAJORITY " + icc.AdminsPolicyKey),
+	}
+}
+
+func defaultOrdererPolicies() map[string]*Policy {
+	result := defaultGroupPolicies()
+	result[BlockValidationPolicyKey] = implicitMetaPolicy("ANY " + icc.WritersPolicyKey)
+	return result
+}
+
+func defaultApplicationPolicies() map[string]*Policy {
+	result := defaultGroupPolicies()
+	result[LifecycleEndorsementPolicyKey] = implicitMetaPolicy("MAJORITY " + EndorsementPolicyKey)
+	result[EndorsementPolicyKey] = implicitMetaPolicy("MAJORITY " + EndorsementPolicyKey)
+	return result
+}
+
+func defaultApplicationOrgPolicies(mspID string) map[string]*Policy {
+	return map[string]*Policy{
+		icc.ReadersPolicyKey: signaturePolicy(fmt.Sprintf("OR('%[1]s.admin', '%[1]s.peer', '%[1]s.client')", mspID)),
+		icc.WritersPolicyKey: signaturePolicy(fmt.Sprintf("OR('%[1]s.admin', '%[1]s.client')", mspID)),
+		icc.AdminsPolicyKey:  signaturePolicy(fmt.Sprintf("OR('%s.admin')", mspID)),
+		EndorsementPolicyKey: signaturePolicy(fmt.Sprintf("OR('%s.peer')", mspID)),
+	}
+}
+
+func defaultOrdererOrgPolicies(mspID string) map[string]*Policy {
+	return map[string]*Policy{
+		icc.ReadersPolicyKey: signaturePolicy(fmt.Sprintf("OR('%s.member')", mspID)),
+		icc.WritersPolicyKey: signaturePolicy(fmt.Sprintf("OR('%s.member')", mspID)),
+		icc.AdminsPolicyKey:  signaturePolicy(fmt.Sprintf("OR('%s.admin')", mspID)),
+	}
+}
+
+func defaultEtcdRaftOptions() *etcdraft.Options {
+	return &etcdraft.Options{
+		TickInterval:         "500ms",
+		ElectionTick:         10,
+		HeartbeatTick:        1,
+		MaxInflightBlocks:    5,
+		SnapshotIntervalSize: 16 * 1024 * 1024, // 16 MB
+	}
+}
+
+func defaultSmartBFTOptions() *smartbft.Options {
+	return &smartbft.Options{
+		RequestBatchMaxCount:      100,
+		RequestBatchMaxBytes:      10 * 1024 * 1024,
+		RequestBatchMaxInterval:   "50ms",
+		IncomingMessageBufferSize: 200,
+		RequestPoolSize:           100000,
+		RequestForwardTimeout:     "2s",
+		RequestComplainTimeout:    "20s",
+		RequestAutoRemoveTimeout:  "3m0s",
+		ViewChangeResendInterval:  "5s",
+		ViewChangeTimeout:         "20s",
+		LeaderHeartbeatTimeout:    "1m0s",
+		LeaderHeartbeatCount:      10,
+		CollectTimeout:            "1s",
+	}
+}
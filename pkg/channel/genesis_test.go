@@ -0,0 +1,178 @@
+package channel_test
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/hyperledger/fabric-admin-sdk/internal/msp"
+	"github.com/hyperledger/fabric-admin-sdk/pkg/channel"
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	ab "github.com/hyperledger/fabric-protos-go-apiv2/orderer"
+	"github.com/hyperledger/fabric-protos-go-apiv2/orderer/etcdraft"
+	pb "github.com/hyperledger/fabric-protos-go-apiv2/peer"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+)
+
+const testDataDir = "../../test/data"
+
+func ReadTestFile(elem ...string) []byte {
+	result, err := os.ReadFile(filepath.Join(append([]string{testDataDir}, elem...)...))
+	Expect(err).NotTo(HaveOccurred())
+	return result
+}
+
+func NewTestGenesisConfig() *channel.GenesisConfig {
+	ordererTLSCert := ReadTestFile("ordererOrganizations", "example.com", "orderers", "orderer.example.com", "tls", "server.crt")
+
+	return &channel.GenesisConfig{
+		Capabilities: []string{"V3_0"},
+		Orderer: &channel.OrdererConfig{
+			OrdererType: channel.ConsensusTypeEtcdRaft,
+			Consenters: []*channel.Consenter{
+				{
+					Host:          "orderer.example.com",
+					Port:          7050,
+					ClientTLSCert: ordererTLSCert,
+					ServerTLSCert: ordererTLSCert,
+				},
+			},
+			Organizations: []*channel.Organization{
+				{
+					Name:             "OrdererOrg",
+					MSPID:            "OrdererMSP",
+					MSPDir:           filepath.Join(testDataDir, "ordererOrganizations", "example.com", "msp"),
+					OrdererEndpoints: []string{"orderer.example.com:7050"},
+				},
+			},
+			Capabilities: []string{"V2_0"},
+		},
+		Application: &channel.ApplicationConfig{
+			Organizations: []*channel.Organization{
+				{
+					MSPID:  "Org1MSP",
+					MSPDir: filepath.Join(testDataDir, "peerOrganizations", "org1.example.com", "msp"),
+					AnchorPeers: []*channel.AnchorPeer{
+						{Host: "peer0.org1.example.com", Port: 7051},
+					},
+				},
+				{
+					MSPID:  "Org2MSP",
+					MSPDir: filepath.Join(testDataDir, "peerOrganizations", "org2.example.com", "msp"),
+				},
+			},
+			Capabilities: []string{"V2_5"},
+		},
+	}
+}
+
+func NewTestGenesisBlock(channelID string) *cb.Block {
+	block, err := channel.NewGenesisBlock(channelID, NewTestGenesisConfig())
+	Expect(err).NotTo(HaveOccurred())
+	return block
+}
+
+var _ = Describe("Genesis block", func() {
+	It("Creates config block for channel", func() {
+		block := NewTestGenesisBlock("mychannel")
+
+		channelID, err := channel.ChannelIDFromBlock(block)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(channelID).To(Equal("mychannel"))
+
+		config, err := channel.ConfigFromBlock(block)
+		Expect(err).NotTo(HaveOccurred())
+
+		channelGroup := config.GetChannelGroup()
+		Expect(channelGroup.GetValues()).To(HaveKey("Capabilities"))
+		Expect(channelGroup.GetPolicies()).To(HaveKey("Admins"))
+		Expect(channelGroup.GetGroups()["Application"].GetGroups()).To(SatisfyAll(HaveKey("Org1MSP"), HaveKey("Org2MSP")))
+		Expect(channelGroup.GetGroups()["Orderer"].GetGroups()).To(HaveKey("OrdererOrg"))
+	})
+
+	It("Applies default batch settings", func() {
+		config, err := channel.ConfigFromBlock(NewTestGenesisBlock("mychannel"))
+		Expect(err).NotTo(HaveOccurred())
+
+		batchSize := &ab.BatchSize{}
+		Expect(proto.Unmarshal(config.GetChannelGroup().GetGroups()["Orderer"].GetValues()["BatchSize"].GetValue(), batchSize)).To(Succeed())
+		Expect(batchSize.GetMaxMessageCount()).To(BeEquivalentTo(500))
+
+		batchTimeout := &ab.BatchTimeout{}
+		Expect(proto.Unmarshal(config.GetChannelGroup().GetGroups()["Orderer"].GetValues()["BatchTimeout"].GetValue(), batchTimeout)).To(Succeed())
+		Expect(batchTimeout.GetTimeout()).To(Equal("2s"))
+	})
+
+	It("Includes etcdraft consenters", func() {
+		config, err := channel.ConfigFromBlock(NewTestGenesisBlock("mychannel"))
+		Expect(err).NotTo(HaveOccurred())
+
+		consensusType := &ab.ConsensusType{}
+		Expect(proto.Unmarshal(config.GetChannelGroup().GetGroups()["Orderer"].GetValues()["ConsensusType"].GetValue(), consensusType)).To(Succeed())
+		Expect(consensusType.GetType()).To(Equal("etcdraft"))
+
+		metadata := &etcdraft.ConfigMetadata{}
+		Expect(proto.Unmarshal(consensusType.GetMetadata(), metadata)).To(Succeed())
+		Expect(metadata.GetConsenters()).To(HaveLen(1))
+		Expect(metadata.GetConsenters()[0].GetHost()).To(Equal("orderer.example.com"))
+		Expect(metadata.GetOptions().GetTickInterval()).To(Equal("500ms"))
+	})
+
+	It("Includes anchor peers", func() {
+		config, err := channel.ConfigFromBlock(NewTestGenesisBlock("mychannel"))
+		Expect(err).NotTo(HaveOccurred())
+
+		org1 := config.GetChannelGroup().GetGroups()["Application"].GetGroups()["Org1MSP"]
+		anchorPeers := &pb.AnchorPeers{}
+		Expect(proto.Unmarshal(org1.GetValues()["AnchorPeers"].GetValue(), anchorPeers)).To(Succeed())
+		Expect(anchorPeers.GetAnchorPeers()).To(HaveLen(1))
+		Expect(anchorPeers.GetAnchorPeers()[0].GetHost()).To(Equal("peer0.org1.example.com"))
+	})
+
+	It("Uses in-memory MSP definition", func() {
+		genesisConfig := NewTestGenesisConfig()
+		org2 := genesisConfig.Application.Organizations[1]
+		mspConfig, err := msp.GetVerifyingMspConfig(org2.MSPDir, org2.MSPID, "bccsp")
+		Expect(err).NotTo(HaveOccurred())
+		org2.MSP = mspConfig
+		org2.MSPDir = ""
+
+		_, err = channel.NewGenesisBlock("mychannel", genesisConfig)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Fails without consenters", func() {
+		genesisConfig := NewTestGenesisConfig()
+		genesisConfig.Orderer.Consenters = nil
+
+		_, err := channel.NewGenesisBlock("mychannel", genesisConfig)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("Fails with unsupported orderer type", func() {
+		genesisConfig := NewTestGenesisConfig()
+		genesisConfig.Orderer.OrdererType = "solo"
+
+		_, err := channel.NewGenesisBlock("mychannel", genesisConfig)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("Fails with BFT consenter missing identity", func() {
+		genesisConfig := NewTestGenesisConfig()
+		genesisConfig.Orderer.OrdererType = channel.ConsensusTypeBFT
+		genesisConfig.Orderer.Consenters[0].ID = 1
+		genesisConfig.Orderer.Consenters[0].MSPID = "OrdererMSP"
+
+		_, err := channel.NewGenesisBlock("mychannel", genesisConfig)
+		Expect(err).To(MatchError(ContainSubstring("identity")))
+	})
+
+	It("Fails with organization missing MSP definition", func() {
+		genesisConfig := NewTestGenesisConfig()
+		genesisConfig.Application.Organizations[1].MSPDir = ""
+
+		_, err := channel.NewGenesisBlock("mychannel", genesisConfig)
+		Expect(err).To(HaveOccurred())
+	})
+})
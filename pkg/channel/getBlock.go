@@ -6,6 +6,7 @@ import (
 	"crypto/tls"
 	"errors"
 	"fmt"
+	"iter"
 
 	"github.com/hyperledger/fabric-admin-sdk/internal/protoutil"
 	"github.com/hyperledger/fabric-admin-sdk/pkg/identity"
@@ -15,19 +16,13 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
-var newest = &ab.SeekPosition{
-	Type: &ab.SeekPosition_Newest{
-		Newest: &ab.SeekNewest{},
-	},
-}
-
 func GetConfigBlockFromOrderer(ctx context.Context, connection grpc.ClientConnInterface, id identity.SigningIdentity, channelID string, certificate tls.Certificate) (*cb.Block, error) {
-	abClient := ab.NewAtomicBroadcastClient(connection)
-	deliverClient, err := abClient.Deliver(ctx)
-	if err != nil {
-		return nil, err
+	options := []DeliverOption{
+		WithDeliverTLSClientCertificateHash(tlsCertificateHash(certificate)),
+		WithBestEffort(),
 	}
-	iBlock, err := getNewestBlock(deliverClient, channelID, certificate, id, true)
+
+	iBlock, err := getBlockBySeekPosition(ctx, connection, id, channelID, SeekNewest(), options...)
 	if err != nil {
 		return nil, err
 	}
@@ -35,77 +30,41 @@ func GetConfigBlockFromOrderer(ctx context.Context, connection grpc.ClientConnIn
 	if err != nil {
 		return nil, err
 	}
-	return getSpecifiedBlock(deliverClient, channelID, certificate, id, true, lc)
+	return getBlockBySeekPosition(ctx, connection, id, channelID, SeekBlock(lc), options...)
 }
 
-func getSpecifiedBlock(Service ab.AtomicBroadcast_DeliverClient, channelID string, Certificate tls.Certificate, signer identity.SigningIdentity, bestEffort bool, num uint64) (*cb.Block, error) {
-	seekPosition := &ab.SeekPosition{
-		Type: &ab.SeekPosition_Specified{
-			Specified: &ab.SeekSpecified{
-				Number: num,
-			},
-		},
-	}
-	return getBlockBySeekPosition(Service, channelID, Certificate, signer, bestEffort, seekPosition)
-}
+func getBlockBySeekPosition(ctx context.Context, connection grpc.ClientConnInterface, signer identity.SigningIdentity, channelID string, seekPosition *ab.SeekPosition, options ...DeliverOption) (*cb.Block, error) {
+	options = append(options, WithSeekRange(seekPosition, seekPosition))
+
+	next, stop := iter.Pull2(Deliver(ctx, connection, signer, channelID, options...))
+	defer stop()
 
-func getBlockBySeekPosition(Service ab.AtomicBroadcast_DeliverClient, channelID string, Certificate tls.Certificate, signer identity.SigningIdentity, bestEffort bool, seekPosition *ab.SeekPosition) (*cb.Block, error) {
-	var tlsCertHash []byte
-	hasher := sha256.New()
-	hasher.Write([]byte(Certificate.Certificate[0]))
-	tlsCertHash = hasher.Sum(nil)
-	env := seekHelper(channelID, seekPosition, tlsCertHash, signer, bestEffort)
-	err := Service.Send(env)
+	block, err, ok := next()
+	if !ok {
+		return nil, errors.New("no block delivered")
+	}
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("can't read the block: %w", err)
 	}
-	return readBlock(Service)
-}
 
-func getNewestBlock(Service ab.AtomicBroadcast_DeliverClient, channelID string, Certificate tls.Certificate, signer identity.SigningIdentity, bestEffort bool) (*cb.Block, error) {
-	return getBlockBySeekPosition(Service, channelID, Certificate, signer, bestEffort, newest)
+	return block, nil
 }
 
-func readBlock(Service ab.AtomicBroadcast_DeliverClient) (*cb.Block, error) {
-	msg, err := Service.Recv()
-	if err != nil {
-		return nil, err
+func tlsCertificateHash(certificate tls.Certificate) []byte {
+	if len(certificate.Certificate) == 0 {
+		return nil
 	}
-	switch t := msg.GetType().(type) {
-	case *ab.DeliverResponse_Status:
-		//logger.Infof("Expect block, but got status: %v", t)
-		return nil, fmt.Errorf("can't read the block: %v", t)
-	case *ab.DeliverResponse_Block:
-		//logger.Infof("Received block: %v", t.Block.Header.Number)
-		if resp, err := Service.Recv(); err != nil { // Flush the success message
-			fmt.Printf("Failed to flush success message: %s", err)
-		} else if status := resp.GetStatus(); status != cb.Status_SUCCESS {
-			fmt.Printf("Expect status to be SUCCESS, got: %s", status)
-		}
 
-		return t.Block, nil
-	default:
-		return nil, fmt.Errorf("response error: unknown type %T", t)
-	}
+	hash := sha256.Sum256(certificate.Certificate[0])
+	return hash[:]
 }
 
 func seekHelper(
 	channelID string,
-	position *ab.SeekPosition,
+	seekInfo *ab.SeekInfo,
 	tlsCertHash []byte,
 	signer identity.SigningIdentity,
-	bestEffort bool,
-) *cb.Envelope {
-	seekInfo := &ab.SeekInfo{
-		Start:    position,
-		Stop:     position,
-		Behavior: ab.SeekInfo_BLOCK_UNTIL_READY,
-	}
-
-	if bestEffort {
-		seekInfo.ErrorResponse = ab.SeekInfo_BEST_EFFORT
-	}
-
+) (*cb.Envelope, error) {
 	env, err := protoutil.CreateSignedEnvelopeWithTLSBinding(
 		cb.HeaderType_DELIVER_SEEK_INFO,
 		channelID,
@@ -116,11 +75,10 @@ func seekHelper(
 		tlsCertHash,
 	)
 	if err != nil {
-		fmt.Printf("Error signing envelope:  %s", err)
-		return nil
+		return nil, fmt.Errorf("error signing envelope: %w", err)
 	}
 
-	return env
+	return env, nil
 }
 
 // GetLastConfigIndexFromBlock retrieves the index of the last config block as
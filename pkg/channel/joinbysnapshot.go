@@ -0,0 +1,79 @@
+package channel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hyperledger/fabric-admin-sdk/pkg/identity"
+	"github.com/hyperledger/fabric-admin-sdk/pkg/internal/proposal"
+	"github.com/hyperledger/fabric-admin-sdk/pkg/wait"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"github.com/hyperledger/fabric-protos-go-apiv2/peer"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// JoinChannelBySnapshot joins a peer to a channel using a ledger snapshot, instead of replaying all blocks from the
+// genesis block. The snapshot path is a directory on the peer's file system that contains a completed snapshot. The
+// peer continues to bootstrap the channel ledger from the snapshot after this function returns. Use
+// JoinBySnapshotStatus or WaitForJoinBySnapshot to check for completion.
+func JoinChannelBySnapshot(ctx context.Context, connection grpc.ClientConnInterface, id identity.SigningIdentity, snapshotPath string) error {
+	_, err := invokeCSCC(ctx, connection, id, "JoinChainBySnapshot", cb.HeaderType_CONFIG, []byte(snapshotPath))
+	return err
+}
+
+// JoinBySnapshotStatus returns the progress of a peer joining a channel by snapshot. If a join is in progress, the
+// status includes the snapshot directory being used to bootstrap the channel ledger.
+func JoinBySnapshotStatus(ctx context.Context, connection grpc.ClientConnInterface, id identity.SigningIdentity) (*peer.JoinBySnapshotStatus, error) {
+	proposalResp, err := invokeCSCC(ctx, connection, id, "JoinBySnapshotStatus", cb.HeaderType_ENDORSER_TRANSACTION)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &peer.JoinBySnapshotStatus{}
+	if err := proto.Unmarshal(proposalResp.GetResponse().GetPayload(), result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal join by snapshot status: %w", err)
+	}
+
+	return result, nil
+}
+
+// WaitForJoinBySnapshot polls a peer using JoinBySnapshotStatus, backing off between checks, until no join by
+// snapshot is in progress. An error is returned if the query fails or the context is done.
+func WaitForJoinBySnapshot(ctx context.Context, connection grpc.ClientConnInterface, id identity.SigningIdentity, options ...wait.Option) error {
+	backoff := wait.NewBackoff(options...)
+
+	return backoff.Until(ctx, func(ctx context.Context) (bool, error) {
+		status, err := JoinBySnapshotStatus(ctx, connection, id)
+		if err != nil {
+			return false, err
+		}
+
+		return !status.GetInProgress(), nil
+	})
+}
+
+func invokeCSCC(ctx context.Context, connection grpc.ClientConnInterface, id identity.SigningIdentity, function string, headerType cb.HeaderType, args ...[]byte) (*peer.ProposalResponse, error) {
+	prop, err := proposal.NewProposal(id, "cscc", function, proposal.WithArguments(args...), proposal.WithType(headerType))
+	if err != nil {
+		return nil, err
+	}
+
+	signedProp, err := proposal.NewSignedProposal(prop, id)
+	if err != nil {
+		return nil, err
+	}
+
+	endorser := peer.NewEndorserClient(connection)
+	proposalResp, err := endorser.ProcessProposal(ctx, signedProp)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := proposal.CheckSuccessfulResponse(proposalResp); err != nil {
+		return nil, err
+	}
+
+	return proposalResp, nil
+}
@@ -0,0 +1,97 @@
+package channel_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/hyperledger/fabric-admin-sdk/pkg/channel"
+	"github.com/hyperledger/fabric-admin-sdk/pkg/identity"
+	"github.com/hyperledger/fabric-admin-sdk/pkg/wait"
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	pb "github.com/hyperledger/fabric-protos-go-apiv2/peer"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+var _ = Describe("Join by snapshot", func() {
+	var id identity.SigningIdentity
+	var invocations []*pb.ChaincodeInvocationSpec
+
+	BeforeEach(func() {
+		id = NewTestOrgAdmin("org1.example.com", "Org1MSP")
+		invocations = nil
+	})
+
+	AssertInvocation := func(function string, args ...string) {
+		Expect(invocations).To(HaveLen(1))
+		spec := invocations[0].GetChaincodeSpec()
+		Expect(spec.GetChaincodeId().GetName()).To(Equal("cscc"))
+
+		expectedArgs := [][]byte{[]byte(function)}
+		for _, arg := range args {
+			expectedArgs = append(expectedArgs, []byte(arg))
+		}
+		Expect(spec.GetInput().GetArgs()).To(Equal(expectedArgs))
+	}
+
+	It("Joins channel using snapshot path", func(specCtx SpecContext) {
+		controller := gomock.NewController(GinkgoT())
+		connection := NewMockEndorser(controller, NewProposalResponse(cb.Status_SUCCESS, "", nil), &invocations)
+
+		err := channel.JoinChannelBySnapshot(specCtx, connection, id, "/var/hyperledger/snapshots/completed/mychannel/100")
+		Expect(err).NotTo(HaveOccurred())
+
+		AssertInvocation("JoinChainBySnapshot", "/var/hyperledger/snapshots/completed/mychannel/100")
+	})
+
+	It("Join returns error response", func(specCtx SpecContext) {
+		controller := gomock.NewController(GinkgoT())
+		connection := NewMockEndorser(controller, NewProposalResponse(cb.Status_INTERNAL_SERVER_ERROR, "EXPECTED_ERROR", nil), &invocations)
+
+		err := channel.JoinChannelBySnapshot(specCtx, connection, id, "SNAPSHOT_PATH")
+
+		Expect(err).To(MatchError(ContainSubstring("EXPECTED_ERROR")))
+	})
+
+	It("Gets join by snapshot status", func(specCtx SpecContext) {
+		expected := &pb.JoinBySnapshotStatus{
+			InProgress:               true,
+			BootstrappingSnapshotDir: "SNAPSHOT_PATH",
+		}
+		controller := gomock.NewController(GinkgoT())
+		connection := NewMockEndorser(controller, NewProposalResponse(cb.Status_SUCCESS, "", AssertMarshal(expected)), &invocations)
+
+		actual, err := channel.JoinBySnapshotStatus(specCtx, connection, id)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(proto.Equal(actual, expected)).To(BeTrue(), "Expected %v, got %v", expected, actual)
+		AssertInvocation("JoinBySnapshotStatus")
+	})
+
+	It("Waits for join by snapshot to complete", func(specCtx SpecContext) {
+		statuses := []*pb.JoinBySnapshotStatus{
+			{InProgress: true, BootstrappingSnapshotDir: "SNAPSHOT_PATH"},
+			{InProgress: true, BootstrappingSnapshotDir: "SNAPSHOT_PATH"},
+			{},
+		}
+		controller := gomock.NewController(GinkgoT())
+		connection := NewMockClientConnInterface(controller)
+		connection.EXPECT().
+			Invoke(gomock.Any(), gomock.Eq(processProposalMethod), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, method string, in *pb.SignedProposal, out *pb.ProposalResponse, opts ...grpc.CallOption) error {
+				status := statuses[0]
+				statuses = statuses[1:]
+				proto.Merge(out, NewProposalResponse(cb.Status_SUCCESS, "", AssertMarshal(status)))
+				return nil
+			}).
+			Times(len(statuses))
+
+		err := channel.WaitForJoinBySnapshot(specCtx, connection, id, wait.WithBackoff(time.Millisecond, 5*time.Millisecond))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(statuses).To(BeEmpty())
+	})
+})
@@ -0,0 +1,250 @@
+package channel
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	icc "github.com/hyperledger/fabric-admin-sdk/internal/channelconfig"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	ab "github.com/hyperledger/fabric-protos-go-apiv2/orderer"
+	"github.com/hyperledger/fabric-protos-go-apiv2/orderer/smartbft"
+)
+
+// bftChannelCapability is the minimum channel capability required for BFT consensus.
+const bftChannelCapability = "V3_0"
+
+// MigrationStep is a step in the migration of a channel from etcdraft to BFT consensus. Each step is performed by
+// a separate config update, which must be signed by orderer admins and committed before the next step.
+type MigrationStep int
+
+const (
+	// MigrationStepEnterMaintenance places the etcdraft ordering service in maintenance mode.
+	MigrationStepEnterMaintenance MigrationStep = iota + 1
+	// MigrationStepSwitchToBFT changes the consensus type to BFT while in maintenance mode.
+	MigrationStepSwitchToBFT
+	// MigrationStepExitMaintenance returns the BFT ordering service to normal operation.
+	MigrationStepExitMaintenance
+	// MigrationComplete indicates that the channel uses BFT consensus in normal operation.
+	MigrationComplete
+)
+
+func (s MigrationStep) String() string {
+	switch s {
+	case MigrationStepEnterMaintenance:
+		return "EnterMaintenance"
+	case MigrationStepSwitchToBFT:
+		return "SwitchToBFT"
+	case MigrationStepExitMaintenance:
+		return "ExitMaintenance"
+	case MigrationComplete:
+		return "Complete"
+	default:
+		return fmt.Sprintf("MigrationStep(%d)", int(s))
+	}
+}
+
+// BFTMigration describes the BFT configuration to which an etcdraft channel is migrated.
+type BFTMigration struct {
+	// Consenters in the BFT consenter set. There must be exactly one consenter for each existing etcdraft consenter,
+	// matched by host and port. TLS certificates not specified are copied from the etcdraft consenter.
+	Consenters []*Consenter
+
+	// Options for BFT consensus. Defaults are used if not specified.
+	Options *smartbft.Options
+}
+
+// NextMigrationStep determines the next step required to migrate a channel from etcdraft to BFT consensus, based on
+// the current channel configuration. This allows an interrupted migration to be resumed from any step.
+func NextMigrationStep(current *cb.Config) (MigrationStep, error) {
+	consensusType, err := (&ChannelConfig{config: current}).consensusType()
+	if err != nil {
+		return 0, err
+	}
+
+	maintenance := consensusType.GetState() == ab.ConsensusType_STATE_MAINTENANCE
+
+	switch consensusType.GetType() {
+	case ConsensusTypeEtcdRaft:
+		if maintenance {
+			return MigrationStepSwitchToBFT, nil
+		}
+		return MigrationStepEnterMaintenance, nil
+	case ConsensusTypeBFT:
+		if maintenance {
+			return MigrationStepExitMaintenance, nil
+		}
+		return MigrationComplete, nil
+	default:
+		return 0, fmt.Errorf("unsupported orderer type for migration: %s", consensusType.GetType())
+	}
+}
+
+// NextMigrationUpdate determines the next step required to migrate a channel from etcdraft to BFT consensus, and
+// creates the unsigned config update for that step. No config update is returned once the migration is complete.
+func NextMigrationUpdate(channelID string, current *cb.Config, migration *BFTMigration) (MigrationStep, *ConfigUpdate, error) {
+	step, err := NextMigrationStep(current)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var configUpdate *ConfigUpdate
+	switch step {
+	case MigrationStepEnterMaintenance:
+		configUpdate, err = EnterMaintenanceMode(channelID, current)
+	case MigrationStepSwitchToBFT:
+		configUpdate, err = SwitchToBFT(channelID, current, migration)
+	case MigrationStepExitMaintenance:
+		configUpdate, err = ExitMaintenanceMode(channelID, current)
+	case MigrationComplete:
+		return step, nil, nil
+	}
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return step, configUpdate, nil
+}
+
+// EnterMaintenanceMode creates an unsigned config update that places an etcdraft ordering service in maintenance
+// mode, which is the first step in migration to BFT consensus.
+func EnterMaintenanceMode(channelID string, current *cb.Config) (*ConfigUpdate, error) {
+	if err := checkMigrationStep(current, MigrationStepEnterMaintenance); err != nil {
+		return nil, err
+	}
+
+	return updateConsensusType(channelID, current, func(consensusType *ab.ConsensusType, _ *cb.ConfigGroup) error {
+		consensusType.State = ab.ConsensusType_STATE_MAINTENANCE
+		return nil
+	})
+}
+
+// SwitchToBFT creates an unsigned config update that changes the consensus type of an etcdraft ordering service in
+// maintenance mode to BFT. The update adds the BFT consenter set and replaces the BlockValidation policy with one
+// requiring signatures from a quorum of the BFT consenters.
+func SwitchToBFT(channelID string, current *cb.Config, migration *BFTMigration) (*ConfigUpdate, error) {
+	if migration == nil {
+		return nil, errors.New("BFT migration configuration is required")
+	}
+
+	if err := checkMigrationStep(current, MigrationStepSwitchToBFT); err != nil {
+		return nil, err
+	}
+
+	channelConfig := &ChannelConfig{config: current}
+	capabilities, err := channelConfig.ChannelCapabilities()
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(capabilities, bftChannelCapability) {
+		return nil, fmt.Errorf("BFT consensus requires channel capability %s, channel has %v", bftChannelCapability, capabilities)
+	}
+
+	raftConsenters, err := channelConfig.Consenters()
+	if err != nil {
+		return nil, err
+	}
+
+	consenters, err := migrationConsenters(raftConsenters, migration.Consenters)
+	if err != nil {
+		return nil, err
+	}
+
+	options := migration.Options
+	if options == nil {
+		options = defaultSmartBFTOptions()
+	}
+
+	metadata, err := icc.MarshalBFTOptions(options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal BFT options: %w", err)
+	}
+
+	return updateConsensusType(channelID, current, func(consensusType *ab.ConsensusType, ordererGroup *cb.ConfigGroup) error {
+		consensusType.Type = ConsensusTypeBFT
+		consensusType.Metadata = metadata
+		return setBFTConsenters(ordererGroup, consenters)
+	})
+}
+
+// ExitMaintenanceMode creates an unsigned config update that returns a BFT ordering service to normal operation,
+// which is the final step in migration from etcdraft consensus.
+func ExitMaintenanceMode(channelID string, current *cb.Config) (*ConfigUpdate, error) {
+	if err := checkMigrationStep(current, MigrationStepExitMaintenance); err != nil {
+		return nil, err
+	}
+
+	return updateConsensusType(channelID, current, func(consensusType *ab.ConsensusType, _ *cb.ConfigGroup) error {
+		consensusType.State = ab.ConsensusType_STATE_NORMAL
+		return nil
+	})
+}
+
+func checkMigrationStep(current *cb.Config, expected MigrationStep) error {
+	step, err := NextMigrationStep(current)
+	if err != nil {
+		return err
+	}
+
+	if step != expected {
+		return fmt.Errorf("cannot perform migration step %s, next step is %s", expected, step)
+	}
+
+	return nil
+}
+
+func updateConsensusType(
+	channelID string,
+	current *cb.Config,
+	modify func(consensusType *ab.ConsensusType, ordererGroup *cb.ConfigGroup) error,
+) (*ConfigUpdate, error) {
+	return newConfigUpdate(channelID, current, func(config *cb.Config) error {
+		ordererGroup, err := findGroup(config.GetChannelGroup(), OrdererGroupKey)
+		if err != nil {
+			return err
+		}
+
+		consensusType := &ab.ConsensusType{}
+		if err := unmarshalValue(ordererGroup, ConsensusTypeKey, consensusType); err != nil {
+			return err
+		}
+
+		if err := modify(consensusType, ordererGroup); err != nil {
+			return err
+		}
+
+		setConfigValue(ordererGroup, ConsensusTypeKey, consensusType, AdminsPolicyKey)
+		return nil
+	})
+}
+
+// migrationConsenters matches the BFT consenters to the existing etcdraft consenters by host and port, copying TLS
+// certificates from the etcdraft consenter where they are not specified.
+func migrationConsenters(raftConsenters, bftConsenters []*Consenter) ([]*Consenter, error) {
+	if len(bftConsenters) != len(raftConsenters) {
+		return nil, fmt.Errorf("expected %d BFT consenters to match etcdraft consenters, got %d", len(raftConsenters), len(bftConsenters))
+	}
+
+	var result []*Consenter
+	for _, raftConsenter := range raftConsenters {
+		index := slices.IndexFunc(bftConsenters, func(c *Consenter) bool {
+			return c.Host == raftConsenter.Host && c.Port == raftConsenter.Port
+		})
+		if index < 0 {
+			return nil, fmt.Errorf("no BFT consenter specified for etcdraft consenter %s:%d", raftConsenter.Host, raftConsenter.Port)
+		}
+
+		consenter := *bftConsenters[index]
+		if len(consenter.ClientTLSCert) == 0 {
+			consenter.ClientTLSCert = raftConsenter.ClientTLSCert
+		}
+		if len(consenter.ServerTLSCert) == 0 {
+			consenter.ServerTLSCert = raftConsenter.ServerTLSCert
+		}
+
+		result = append(result, &consenter)
+	}
+
+	return result, nil
+}
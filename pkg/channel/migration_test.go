@@ -0,0 +1,104 @@
+package channel_test
+
+import (
+	"github.com/hyperledger/fabric-admin-sdk/pkg/channel"
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	ab "github.com/hyperledger/fabric-protos-go-apiv2/orderer"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BFT migration", func() {
+	var config *cb.Config
+	var migration *channel.BFTMigration
+
+	BeforeEach(func() {
+		config = NewTestConfig()
+
+		identity := ReadTestFile("ordererOrganizations", "example.com", "orderers", "orderer.example.com", "msp", "signcerts", "orderer.example.com-cert.pem")
+		migration = &channel.BFTMigration{
+			Consenters: []*channel.Consenter{
+				{
+					ID:       1,
+					Host:     "orderer.example.com",
+					Port:     7050,
+					MSPID:    "OrdererMSP",
+					Identity: identity,
+				},
+			},
+		}
+	})
+
+	It("Migrates through each step", func() {
+		var steps []channel.MigrationStep
+		for {
+			step, configUpdate, err := channel.NextMigrationUpdate("mychannel", config, migration)
+			Expect(err).NotTo(HaveOccurred())
+
+			steps = append(steps, step)
+			if step == channel.MigrationComplete {
+				Expect(configUpdate).To(BeNil())
+				break
+			}
+
+			config = UpdatedConfig(config, configUpdate).Config()
+		}
+
+		Expect(steps).To(Equal([]channel.MigrationStep{
+			channel.MigrationStepEnterMaintenance,
+			channel.MigrationStepSwitchToBFT,
+			channel.MigrationStepExitMaintenance,
+			channel.MigrationComplete,
+		}))
+
+		channelConfig := AssertChannelConfig(config)
+		Expect(channelConfig.OrdererType()).To(Equal(channel.ConsensusTypeBFT))
+		Expect(channelConfig.ConsensusState()).To(Equal(ab.ConsensusType_STATE_NORMAL))
+
+		consenters, err := channelConfig.Consenters()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(consenters).To(HaveLen(1))
+		Expect(consenters[0].ServerTLSCert).NotTo(BeEmpty(), "TLS certificate copied from etcdraft consenter")
+
+		Expect(BlockValidationPolicy(channelConfig).GetIdentities()).To(HaveLen(1))
+	})
+
+	It("Enters maintenance mode without changing consensus type", func() {
+		configUpdate, err := channel.EnterMaintenanceMode("mychannel", config)
+		Expect(err).NotTo(HaveOccurred())
+
+		channelConfig := UpdatedConfig(config, configUpdate)
+		Expect(channelConfig.OrdererType()).To(Equal(channel.ConsensusTypeEtcdRaft))
+		Expect(channelConfig.ConsensusState()).To(Equal(ab.ConsensusType_STATE_MAINTENANCE))
+	})
+
+	It("Refuses to switch to BFT outside maintenance mode", func() {
+		_, err := channel.SwitchToBFT("mychannel", config, migration)
+		Expect(err).To(MatchError(ContainSubstring("next step is EnterMaintenance")))
+	})
+
+	It("Refuses to switch to BFT with mismatched consenters", func() {
+		configUpdate, err := channel.EnterMaintenanceMode("mychannel", config)
+		Expect(err).NotTo(HaveOccurred())
+		config = UpdatedConfig(config, configUpdate).Config()
+
+		migration.Consenters[0].Host = "orderer9.example.com"
+		_, err = channel.SwitchToBFT("mychannel", config, migration)
+		Expect(err).To(MatchError(ContainSubstring("orderer.example.com:7050")))
+	})
+
+	It("Fails at switch to BFT step without migration configuration", func() {
+		step, configUpdate, err := channel.NextMigrationUpdate("mychannel", config, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(step).To(Equal(channel.MigrationStepEnterMaintenance))
+		config = UpdatedConfig(config, configUpdate).Config()
+
+		_, _, err = channel.NextMigrationUpdate("mychannel", config, nil)
+		Expect(err).To(MatchError("BFT migration configuration is required"))
+	})
+
+	It("Refuses to migrate channel already using BFT", func() {
+		_, err := channel.EnterMaintenanceMode("mychannel", NewTestBFTConfig(4))
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,299 @@
+package channel
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"time"
+
+	imsp "github.com/hyperledger/fabric-admin-sdk/internal/msp"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"github.com/hyperledger/fabric-protos-go-apiv2/msp"
+	"google.golang.org/protobuf/proto"
+)
+
+// mspVerifier validates identities issued by an organization's MSP, and classifies them against MSP principals.
+type mspVerifier struct {
+	mspID         string
+	verifyOptions x509.VerifyOptions
+	admins        [][]byte
+	nodeOUs       *msp.FabricNodeOUs
+}
+
+// signedData is a signature over some data, along with the serialized identity of the signer.
+type signedData struct {
+	data      []byte
+	identity  []byte
+	signature []byte
+}
+
+// validIdentity is a signer whose certificate and signature have been verified by its MSP.
+type validIdentity struct {
+	serialized  []byte
+	mspID       string
+	certificate *x509.Certificate
+	verifier    *mspVerifier
+}
+
+// mspVerifiers returns verifiers for the MSPs of all application and orderer organizations in a channel
+// configuration, keyed by MSP ID. Organizations using MSP types other than Fabric X.509 MSPs are ignored.
+func mspVerifiers(config *cb.Config) (map[string]*mspVerifier, error) {
+	result := make(map[string]*mspVerifier)
+
+	for _, parentKey := range []string{ApplicationGroupKey, OrdererGroupKey} {
+		parent, ok := config.GetChannelGroup().GetGroups()[parentKey]
+		if !ok {
+			continue
+		}
+
+		for orgName, orgGroup := range parent.GetGroups() {
+			mspConfig := &msp.MSPConfig{}
+			if err := unmarshalValue(orgGroup, MSPKey, mspConfig); err != nil {
+				return nil, fmt.Errorf("organization %s: %w", orgName, err)
+			}
+			if imsp.ProviderType(mspConfig.GetType()) != imsp.FABRIC {
+				continue
+			}
+
+			verifier, err := newMSPVerifier(mspConfig)
+			if err != nil {
+				return nil, fmt.Errorf("organization %s: %w", orgName, err)
+			}
+
+			result[verifier.mspID] = verifier
+		}
+	}
+
+	return result, nil
+}
+
+func newMSPVerifier(mspConfig *msp.MSPConfig) (*mspVerifier, error) {
+	fabricConfig := &msp.FabricMSPConfig{}
+	if err := proto.Unmarshal(mspConfig.GetConfig(), fabricConfig); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal MSP config: %w", err)
+	}
+
+	roots, err := certPool(fabricConfig.GetRootCerts())
+	if err != nil {
+		return nil, fmt.Errorf("invalid root certificate for MSP %s: %w", fabricConfig.GetName(), err)
+	}
+
+	intermediates, err := certPool(fabricConfig.GetIntermediateCerts())
+	if err != nil {
+		return nil, fmt.Errorf("invalid intermediate certificate for MSP %s: %w", fabricConfig.GetName(), err)
+	}
+
+	var admins [][]byte
+	for _, admin := range fabricConfig.GetAdmins() {
+		certificate, err := parseCertificate(admin)
+		if err != nil {
+			return nil, fmt.Errorf("invalid admin certificate for MSP %s: %w", fabricConfig.GetName(), err)
+		}
+		admins = append(admins, certificate.Raw)
+	}
+
+	return &mspVerifier{
+		mspID: fabricConfig.GetName(),
+		verifyOptions: x509.VerifyOptions{
+			Roots:         roots,
+			Intermediates: intermediates,
+			KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+		},
+		admins:  admins,
+		nodeOUs: fabricConfig.GetFabricNodeOus(),
+	}, nil
+}
+
+// validate checks that a certificate was issued by the MSP. As with Fabric MSPs, certificate expiry is not
+// considered when validating the certificate chain.
+func (v *mspVerifier) validate(certificate *x509.Certificate) error {
+	options := v.verifyOptions
+	options.CurrentTime = certificate.NotBefore.Add(time.Second)
+
+	if _, err := certificate.Verify(options); err != nil {
+		return fmt.Errorf("certificate not issued by MSP %s: %w", v.mspID, err)
+	}
+
+	return nil
+}
+
+// hasRole reports whether a valid identity of this MSP has the specified role.
+func (v *mspVerifier) hasRole(certificate *x509.Certificate, role msp.MSPRole_MSPRoleType) bool {
+	switch role {
+	case msp.MSPRole_MEMBER:
+		return true
+	case msp.MSPRole_ADMIN:
+		if slices.ContainsFunc(v.admins, func(admin []byte) bool { return bytes.Equal(admin, certificate.Raw) }) {
+			return true
+		}
+		return v.hasNodeOU(certificate, v.nodeOUs.GetAdminOuIdentifier())
+	case msp.MSPRole_CLIENT:
+		return v.hasNodeOU(certificate, v.nodeOUs.GetClientOuIdentifier())
+	case msp.MSPRole_PEER:
+		return v.hasNodeOU(certificate, v.nodeOUs.GetPeerOuIdentifier())
+	case msp.MSPRole_ORDERER:
+		return v.hasNodeOU(certificate, v.nodeOUs.GetOrdererOuIdentifier())
+	default:
+		return false
+	}
+}
+
+func (v *mspVerifier) hasNodeOU(certificate *x509.Certificate, ou *msp.FabricOUIdentifier) bool {
+	if !v.nodeOUs.GetEnable() || ou == nil {
+		return false
+	}
+
+	return slices.Contains(certificate.Subject.OrganizationalUnit, ou.GetOrganizationalUnitIdentifier())
+}
+
+// validIdentities returns the distinct signers whose identity is valid for a known MSP and whose signature is
+// correct. Invalid signatures are ignored, as they are when Fabric evaluates policies.
+func validIdentities(signatures []*signedData, verifiers map[string]*mspVerifier) []*validIdentity {
+	var result []*validIdentity
+	for _, signature := range signatures {
+		if slices.ContainsFunc(result, func(id *validIdentity) bool { return bytes.Equal(id.serialized, signature.identity) }) {
+			continue
+		}
+
+		id, err := verifySignedData(signature, verifiers)
+		if err != nil {
+			continue
+		}
+
+		result = append(result, id)
+	}
+
+	return result
+}
+
+func verifySignedData(signature *signedData, verifiers map[string]*mspVerifier) (*validIdentity, error) {
+	serializedIdentity := &msp.SerializedIdentity{}
+	if err := proto.Unmarshal(signature.identity, serializedIdentity); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal signer identity: %w", err)
+	}
+
+	verifier, ok := verifiers[serializedIdentity.GetMspid()]
+	if !ok {
+		return nil, fmt.Errorf("unknown MSP: %s", serializedIdentity.GetMspid())
+	}
+
+	certificate, err := parseCertificate(serializedIdentity.GetIdBytes())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := verifier.validate(certificate); err != nil {
+		return nil, err
+	}
+
+	if err := verifySignature(certificate, signature.data, signature.signature); err != nil {
+		return nil, err
+	}
+
+	return &validIdentity{
+		serialized:  signature.identity,
+		mspID:       verifier.mspID,
+		certificate: certificate,
+		verifier:    verifier,
+	}, nil
+}
+
+// satisfies reports whether the identity satisfies an MSP principal.
+func (id *validIdentity) satisfies(principal *msp.MSPPrincipal) bool {
+	switch principal.GetPrincipalClassification() {
+	case msp.MSPPrincipal_ROLE:
+		role := &msp.MSPRole{}
+		if err := proto.Unmarshal(principal.GetPrincipal(), role); err != nil {
+			return false
+		}
+		return role.GetMspIdentifier() == id.mspID && id.verifier.hasRole(id.certificate, role.GetRole())
+
+	case msp.MSPPrincipal_IDENTITY:
+		expected := &msp.SerializedIdentity{}
+		if err := proto.Unmarshal(principal.GetPrincipal(), expected); err != nil {
+			return false
+		}
+		expectedCertificate, err := parseCertificate(expected.GetIdBytes())
+		if err != nil {
+			return false
+		}
+		return expected.GetMspid() == id.mspID && bytes.Equal(expectedCertificate.Raw, id.certificate.Raw)
+
+	default:
+		return false
+	}
+}
+
+func verifySignature(certificate *x509.Certificate, message []byte, signature []byte) error {
+	switch publicKey := certificate.PublicKey.(type) {
+	case *ecdsa.PublicKey:
+		digest := sha256.Sum256(message)
+		if !isLowS(publicKey.Curve, signature) {
+			return errors.New("invalid signature: S is not low")
+		}
+		if !ecdsa.VerifyASN1(publicKey, digest[:], signature) {
+			return errors.New("invalid signature")
+		}
+		return nil
+
+	case ed25519.PublicKey:
+		if !ed25519.Verify(publicKey, message, signature) {
+			return errors.New("invalid signature")
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("unsupported public key type: %T", certificate.PublicKey)
+	}
+}
+
+// isLowS reports whether an ASN.1 encoded ECDSA signature uses a low S value, which Fabric requires to prevent
+// signature malleability.
+func isLowS(curve elliptic.Curve, signature []byte) bool {
+	var sig struct {
+		R, S *big.Int
+	}
+	if _, err := asn1.Unmarshal(signature, &sig); err != nil || sig.S == nil {
+		return false
+	}
+
+	halfOrder := new(big.Int).Rsh(curve.Params().N, 1)
+	return sig.S.Cmp(halfOrder) <= 0
+}
+
+func parseCertificate(certificatePEM []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(certificatePEM)
+	if block == nil {
+		return nil, errors.New("no PEM certificate found")
+	}
+
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse certificate: %w", err)
+	}
+
+	return certificate, nil
+}
+
+func certPool(certificatesPEM [][]byte) (*x509.CertPool, error) {
+	result := x509.NewCertPool()
+	for _, certificatePEM := range certificatesPEM {
+		certificate, err := parseCertificate(certificatePEM)
+		if err != nil {
+			return nil, err
+		}
+		result.AddCert(certificate)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,71 @@
+package channel
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/fabric-admin-sdk/internal/configtxgen/encoder"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+)
+
+// AddApplicationOrganization creates an unsigned config update that adds an organization to the application group
+// of a channel. The organization's MSP definition is loaded from either MSPDir or MSP. If no policies are specified,
+// Readers, Writers, Admins and Endorsement policies are created for the organization's MSP ID.
+func AddApplicationOrganization(channelID string, current *cb.Config, org *Organization) (*ConfigUpdate, error) {
+	genesisOrg, err := org.toGenesis(defaultApplicationOrgPolicies)
+	if err != nil {
+		return nil, err
+	}
+
+	orgGroup, err := encoder.NewApplicationOrgGroup(genesisOrg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create organization group: %w", err)
+	}
+
+	return newConfigUpdate(channelID, current, func(config *cb.Config) error {
+		applicationGroup, err := findGroup(config.GetChannelGroup(), ApplicationGroupKey)
+		if err != nil {
+			return err
+		}
+
+		if _, exists := applicationGroup.GetGroups()[genesisOrg.Name]; exists {
+			return fmt.Errorf("organization %s already exists in channel %s", genesisOrg.Name, channelID)
+		}
+
+		existingOrgs, err := organizationConfigs(applicationGroup)
+		if err != nil {
+			return err
+		}
+		for _, existing := range existingOrgs {
+			if existing.MSPID == genesisOrg.ID {
+				return fmt.Errorf("MSP ID %s is already used by organization %s in channel %s", genesisOrg.ID, existing.Name, channelID)
+			}
+		}
+
+		if applicationGroup.Groups == nil {
+			applicationGroup.Groups = make(map[string]*cb.ConfigGroup)
+		}
+		applicationGroup.Groups[genesisOrg.Name] = orgGroup
+
+		return nil
+	})
+}
+
+// RemoveApplicationOrganization creates an unsigned config update that removes the named organization from the
+// application group of a channel.
+func RemoveApplicationOrganization(channelID string, current *cb.Config, orgName string) (*ConfigUpdate, error) {
+	return newConfigUpdate(channelID, current, func(config *cb.Config) error {
+		applicationGroup, err := findGroup(config.GetChannelGroup(), ApplicationGroupKey)
+		if err != nil {
+			return err
+		}
+
+		if _, exists := applicationGroup.GetGroups()[orgName]; !exists {
+			return fmt.Errorf("organization %s does not exist in channel %s", orgName, channelID)
+		}
+
+		delete(applicationGroup.Groups, orgName)
+
+		return nil
+	})
+}
@@ -0,0 +1,94 @@
+package channel_test
+
+import (
+	"path/filepath"
+
+	"github.com/hyperledger/fabric-admin-sdk/pkg/channel"
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func NewTestConfig() *cb.Config {
+	config, err := channel.ConfigFromBlock(NewTestGenesisBlock("mychannel"))
+	Expect(err).NotTo(HaveOccurred())
+	return config
+}
+
+func AssertConfigUpdate(configUpdate *channel.ConfigUpdate) *cb.ConfigUpdate {
+	result, err := configUpdate.ConfigUpdate()
+	Expect(err).NotTo(HaveOccurred())
+	return result
+}
+
+var _ = Describe("Application organization", func() {
+	var config *cb.Config
+
+	BeforeEach(func() {
+		genesisConfig := NewTestGenesisConfig()
+		genesisConfig.Application.Organizations = genesisConfig.Application.Organizations[:1]
+
+		block, err := channel.NewGenesisBlock("mychannel", genesisConfig)
+		Expect(err).NotTo(HaveOccurred())
+
+		config, err = channel.ConfigFromBlock(block)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Adds organization with default policies", func() {
+		configUpdate, err := channel.AddApplicationOrganization("mychannel", config, &channel.Organization{
+			MSPID:  "Org2MSP",
+			MSPDir: filepath.Join(testDataDir, "peerOrganizations", "org2.example.com", "msp"),
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		update := AssertConfigUpdate(configUpdate)
+		Expect(update.GetChannelId()).To(Equal("mychannel"))
+
+		applicationGroup := update.GetWriteSet().GetGroups()[channel.ApplicationGroupKey]
+		Expect(applicationGroup.GetVersion()).To(BeEquivalentTo(1))
+
+		orgGroup := applicationGroup.GetGroups()["Org2MSP"]
+		Expect(orgGroup.GetModPolicy()).To(Equal(channel.AdminsPolicyKey))
+		Expect(orgGroup.GetValues()).To(HaveKey(channel.MSPKey))
+		Expect(orgGroup.GetPolicies()).To(SatisfyAll(
+			HaveKey(channel.ReadersPolicyKey),
+			HaveKey(channel.WritersPolicyKey),
+			HaveKey(channel.AdminsPolicyKey),
+			HaveKey(channel.EndorsementPolicyKey),
+		))
+		Expect(configUpdate.Signatures()).To(BeEmpty())
+	})
+
+	It("Fails to add organization that already exists", func() {
+		_, err := channel.AddApplicationOrganization("mychannel", config, &channel.Organization{
+			MSPID:  "Org1MSP",
+			MSPDir: filepath.Join(testDataDir, "peerOrganizations", "org1.example.com", "msp"),
+		})
+		Expect(err).To(MatchError(ContainSubstring("already exists")))
+	})
+
+	It("Fails to add organization with MSP ID used by another organization", func() {
+		_, err := channel.AddApplicationOrganization("mychannel", config, &channel.Organization{
+			Name:   "Other",
+			MSPID:  "Org1MSP",
+			MSPDir: filepath.Join(testDataDir, "peerOrganizations", "org1.example.com", "msp"),
+		})
+		Expect(err).To(MatchError(ContainSubstring("already used")))
+	})
+
+	It("Removes organization", func() {
+		configUpdate, err := channel.RemoveApplicationOrganization("mychannel", config, "Org1MSP")
+		Expect(err).NotTo(HaveOccurred())
+
+		update := AssertConfigUpdate(configUpdate)
+		applicationGroup := update.GetWriteSet().GetGroups()[channel.ApplicationGroupKey]
+		Expect(applicationGroup.GetVersion()).To(BeEquivalentTo(1))
+		Expect(applicationGroup.GetGroups()).NotTo(HaveKey("Org1MSP"))
+	})
+
+	It("Fails to remove organization that does not exist", func() {
+		_, err := channel.RemoveApplicationOrganization("mychannel", config, "Org2MSP")
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,190 @@
+package channel
+
+import (
+	"context"
+	"iter"
+
+	"github.com/hyperledger/fabric-admin-sdk/pkg/block"
+	"github.com/hyperledger/fabric-admin-sdk/pkg/identity"
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"github.com/hyperledger/fabric-protos-go-apiv2/ledger/rwset"
+	pb "github.com/hyperledger/fabric-protos-go-apiv2/peer"
+	"google.golang.org/grpc"
+)
+
+// BlockEvent describes a block committed by a peer.
+type BlockEvent struct {
+	// Number of the block.
+	Number uint64
+
+	// Transactions contained in the block, in block order.
+	Transactions []*TransactionEvent
+
+	// Block is the complete block. It is nil for events obtained using PeerDeliverFiltered.
+	Block *cb.Block
+
+	// PrivateData contains the private data read/write sets for transactions in the block, keyed by transaction index.
+	// It is populated only for events obtained using PeerDeliverWithPrivateData, and only with private data that the
+	// client identity is authorized to read.
+	PrivateData map[uint64]*rwset.TxPvtReadWriteSet
+}
+
+// TransactionEvent describes a transaction committed by a peer.
+type TransactionEvent struct {
+	// TxID is the transaction ID.
+	TxID string
+
+	// Type of the transaction, such as HeaderType_ENDORSER_TRANSACTION or HeaderType_CONFIG.
+	Type cb.HeaderType
+
+	// ValidationCode assigned to the transaction by the peer. Only transactions with the code TxValidationCode_VALID
+	// update the ledger.
+	ValidationCode pb.TxValidationCode
+
+	// ChaincodeEvents emitted by the transaction. Chaincode events are included regardless of the validation code.
+	// Chaincode events obtained using PeerDeliverFiltered contain no payload.
+	ChaincodeEvents []*pb.ChaincodeEvent
+}
+
+// PeerDeliver streams events for complete blocks committed by a peer, using the Deliver service of the peer. The
+// range of blocks and other delivery behavior are specified with the same options used for Deliver.
+func PeerDeliver(ctx context.Context, connection grpc.ClientConnInterface, id identity.SigningIdentity, channelID string, options ...DeliverOption) iter.Seq2[*BlockEvent, error] {
+	client := pb.NewDeliverClient(connection)
+	return peerDeliver(ctx, func(ctx context.Context) (deliverStream[*pb.DeliverResponse], error) {
+		return client.Deliver(ctx)
+	}, id, channelID, options)
+}
+
+// PeerDeliverFiltered streams events for filtered blocks committed by a peer, using the Deliver service of the peer.
+// Filtered blocks contain only transaction IDs, types, validation codes and chaincode events without payloads, so
+// they can be obtained by identities that are not authorized to read complete blocks.
+func PeerDeliverFiltered(ctx context.Context, connection grpc.ClientConnInterface, id identity.SigningIdentity, channelID string, options ...DeliverOption) iter.Seq2[*BlockEvent, error] {
+	client := pb.NewDeliverClient(connection)
+	return peerDeliver(ctx, func(ctx context.Context) (deliverStream[*pb.DeliverResponse], error) {
+		return client.DeliverFiltered(ctx)
+	}, id, channelID, options)
+}
+
+// PeerDeliverWithPrivateData streams events for complete blocks committed by a peer, along with the private data
+// for transactions in each block, using the Deliver service of the peer.
+func PeerDeliverWithPrivateData(ctx context.Context, connection grpc.ClientConnInterface, id identity.SigningIdentity, channelID string, options ...DeliverOption) iter.Seq2[*BlockEvent, error] {
+	client := pb.NewDeliverClient(connection)
+	return peerDeliver(ctx, func(ctx context.Context) (deliverStream[*pb.DeliverResponse], error) {
+		return client.DeliverWithPrivateData(ctx)
+	}, id, channelID, options)
+}
+
+func peerDeliver(
+	ctx context.Context,
+	open func(ctx context.Context) (deliverStream[*pb.DeliverResponse], error),
+	id identity.SigningIdentity,
+	channelID string,
+	options []DeliverOption,
+) iter.Seq2[*BlockEvent, error] {
+	d := &deliverer[*pb.DeliverResponse, *pb.DeliverResponse]{
+		open:      open,
+		content:   peerDeliverContent,
+		channelID: channelID,
+		signer:    id,
+		options:   newDeliverOptions(options...),
+	}
+
+	return func(yield func(*BlockEvent, error) bool) {
+		for response, err := range d.deliver(ctx) {
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			event, err := newBlockEvent(response)
+			if !yield(event, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+func peerDeliverContent(response *pb.DeliverResponse) (*pb.DeliverResponse, uint64, bool) {
+	switch content := response.GetType().(type) {
+	case *pb.DeliverResponse_Block:
+		return response, content.Block.GetHeader().GetNumber(), true
+	case *pb.DeliverResponse_FilteredBlock:
+		return response, content.FilteredBlock.GetNumber(), true
+	case *pb.DeliverResponse_BlockAndPrivateData:
+		return response, content.BlockAndPrivateData.GetBlock().GetHeader().GetNumber(), true
+	default:
+		return response, 0, false
+	}
+}
+
+func newBlockEvent(response *pb.DeliverResponse) (*BlockEvent, error) {
+	if filteredBlock := response.GetFilteredBlock(); filteredBlock != nil {
+		return newFilteredBlockEvent(filteredBlock), nil
+	}
+
+	if blockAndPrivateData := response.GetBlockAndPrivateData(); blockAndPrivateData != nil {
+		event, err := newCompleteBlockEvent(blockAndPrivateData.GetBlock())
+		if err != nil {
+			return nil, err
+		}
+
+		event.PrivateData = blockAndPrivateData.GetPrivateDataMap()
+		return event, nil
+	}
+
+	return newCompleteBlockEvent(response.GetBlock())
+}
+
+func newFilteredBlockEvent(filteredBlock *pb.FilteredBlock) *BlockEvent {
+	result := &BlockEvent{
+		Number: filteredBlock.GetNumber(),
+	}
+
+	for _, transaction := range filteredBlock.GetFilteredTransactions() {
+		event := &TransactionEvent{
+			TxID:           transaction.GetTxid(),
+			Type:           transaction.GetType(),
+			ValidationCode: transaction.GetTxValidationCode(),
+		}
+
+		for _, action := range transaction.GetTransactionActions().GetChaincodeActions() {
+			if action.GetChaincodeEvent() != nil {
+				event.ChaincodeEvents = append(event.ChaincodeEvents, action.GetChaincodeEvent())
+			}
+		}
+
+		result.Transactions = append(result.Transactions, event)
+	}
+
+	return result
+}
+
+func newCompleteBlockEvent(cBlock *cb.Block) (*BlockEvent, error) {
+	decoded, err := block.Decode(cBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &BlockEvent{
+		Number: decoded.Number,
+		Block:  cBlock,
+	}
+
+	for _, transaction := range decoded.Transactions {
+		event := &TransactionEvent{
+			TxID:           transaction.TxID,
+			Type:           transaction.Type,
+			ValidationCode: transaction.ValidationCode,
+		}
+
+		for _, action := range transaction.Actions {
+			if action.Event != nil {
+				event.ChaincodeEvents = append(event.ChaincodeEvents, action.Event)
+			}
+		}
+
+		result.Transactions = append(result.Transactions, event)
+	}
+
+	return result, nil
+}
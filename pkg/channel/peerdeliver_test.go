@@ -0,0 +1,243 @@
+package channel_test
+
+import (
+	"errors"
+
+	"github.com/hyperledger/fabric-admin-sdk/pkg/channel"
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"github.com/hyperledger/fabric-protos-go-apiv2/ledger/rwset"
+	pb "github.com/hyperledger/fabric-protos-go-apiv2/peer"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	peerDeliverMethod                = "/protos.Deliver/Deliver"
+	peerDeliverFilteredMethod        = "/protos.Deliver/DeliverFiltered"
+	peerDeliverWithPrivateDataMethod = "/protos.Deliver/DeliverWithPrivateData"
+)
+
+func NewEndorserTransactionEnvelope(txID string, event *pb.ChaincodeEvent) []byte {
+	chaincodeAction := &pb.ChaincodeAction{
+		Events: AssertMarshal(event),
+	}
+
+	actionPayload := &pb.ChaincodeActionPayload{
+		Action: &pb.ChaincodeEndorsedAction{
+			ProposalResponsePayload: AssertMarshal(&pb.ProposalResponsePayload{
+				Extension: AssertMarshal(chaincodeAction),
+			}),
+		},
+	}
+
+	payload := &cb.Payload{
+		Header: &cb.Header{
+			ChannelHeader: AssertMarshal(&cb.ChannelHeader{
+				Type:      int32(cb.HeaderType_ENDORSER_TRANSACTION),
+				ChannelId: "mychannel",
+				TxId:      txID,
+			}),
+			SignatureHeader: AssertMarshal(&cb.SignatureHeader{}),
+		},
+		Data: AssertMarshal(&pb.Transaction{
+			Actions: []*pb.TransactionAction{
+				{Payload: AssertMarshal(actionPayload)},
+			},
+		}),
+	}
+
+	return AssertMarshal(&cb.Envelope{Payload: AssertMarshal(payload)})
+}
+
+func NewCommittedBlock(number uint64) *cb.Block {
+	metadata := make([][]byte, len(cb.BlockMetadataIndex_name))
+	metadata[cb.BlockMetadataIndex_TRANSACTIONS_FILTER] = []byte{
+		byte(pb.TxValidationCode_VALID),
+		byte(pb.TxValidationCode_ENDORSEMENT_POLICY_FAILURE),
+	}
+
+	return &cb.Block{
+		Header: &cb.BlockHeader{Number: number},
+		Data: &cb.BlockData{
+			Data: [][]byte{
+				NewEndorserTransactionEnvelope("TX_ID_1", &pb.ChaincodeEvent{ChaincodeId: "basic", TxId: "TX_ID_1", EventName: "EVENT", Payload: []byte("PAYLOAD")}),
+				NewEndorserTransactionEnvelope("TX_ID_2", &pb.ChaincodeEvent{ChaincodeId: "basic", TxId: "TX_ID_2", EventName: "EVENT"}),
+			},
+		},
+		Metadata: &cb.BlockMetadata{Metadata: metadata},
+	}
+}
+
+func NewPeerStatusResponse(status cb.Status) *pb.DeliverResponse {
+	return &pb.DeliverResponse{
+		Type: &pb.DeliverResponse_Status{
+			Status: status,
+		},
+	}
+}
+
+var _ = Describe("PeerDeliver", func() {
+	var requests []*SeekRequest
+
+	BeforeEach(func() {
+		requests = nil
+	})
+
+	ReadEvents := func(events func(func(*channel.BlockEvent, error) bool)) ([]*channel.BlockEvent, error) {
+		var result []*channel.BlockEvent
+		for event, err := range events {
+			if err != nil {
+				return result, err
+			}
+			result = append(result, event)
+		}
+		return result, nil
+	}
+
+	It("Complete block events", func(specCtx SpecContext) {
+		expectedBlock := NewCommittedBlock(5)
+
+		controller := gomock.NewController(GinkgoT())
+		connection := NewMockDeliverConnection(controller, peerDeliverMethod, &requests, []any{
+			&pb.DeliverResponse{Type: &pb.DeliverResponse_Block{Block: expectedBlock}},
+			NewPeerStatusResponse(cb.Status_SUCCESS),
+		})
+		id := NewTestOrgAdmin("org1.example.com", "Org1MSP")
+
+		actual, err := ReadEvents(channel.PeerDeliver(specCtx, connection, id, "mychannel",
+			channel.WithSeekRange(channel.SeekBlock(5), channel.SeekBlock(5)),
+			channel.WithDeliverTLSClientCertificateHash([]byte("TLS_CERT_HASH")),
+		))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(actual).To(HaveLen(1))
+		Expect(actual[0].Number).To(Equal(uint64(5)))
+		Expect(proto.Equal(actual[0].Block, expectedBlock)).To(BeTrue())
+		Expect(actual[0].PrivateData).To(BeNil())
+
+		transactions := actual[0].Transactions
+		Expect(transactions).To(HaveLen(2))
+		Expect(transactions[0].TxID).To(Equal("TX_ID_1"))
+		Expect(transactions[0].Type).To(Equal(cb.HeaderType_ENDORSER_TRANSACTION))
+		Expect(transactions[0].ValidationCode).To(Equal(pb.TxValidationCode_VALID))
+		Expect(transactions[0].ChaincodeEvents).To(HaveLen(1))
+		Expect(transactions[0].ChaincodeEvents[0].GetEventName()).To(Equal("EVENT"))
+		Expect(transactions[0].ChaincodeEvents[0].GetPayload()).To(Equal([]byte("PAYLOAD")))
+		Expect(transactions[1].TxID).To(Equal("TX_ID_2"))
+		Expect(transactions[1].ValidationCode).To(Equal(pb.TxValidationCode_ENDORSEMENT_POLICY_FAILURE))
+
+		Expect(requests).To(HaveLen(1))
+		AssertSeekPosition(channel.SeekBlock(5), requests[0].SeekInfo.GetStart())
+		Expect(requests[0].TLSCertHash).To(Equal([]byte("TLS_CERT_HASH")))
+	})
+
+	It("Filtered block events", func(specCtx SpecContext) {
+		filteredBlock := &pb.FilteredBlock{
+			ChannelId: "mychannel",
+			Number:    5,
+			FilteredTransactions: []*pb.FilteredTransaction{
+				{
+					Txid:             "TX_ID",
+					Type:             cb.HeaderType_ENDORSER_TRANSACTION,
+					TxValidationCode: pb.TxValidationCode_MVCC_READ_CONFLICT,
+					Data: &pb.FilteredTransaction_TransactionActions{
+						TransactionActions: &pb.FilteredTransactionActions{
+							ChaincodeActions: []*pb.FilteredChaincodeAction{
+								{ChaincodeEvent: &pb.ChaincodeEvent{ChaincodeId: "basic", TxId: "TX_ID", EventName: "EVENT"}},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		controller := gomock.NewController(GinkgoT())
+		connection := NewMockDeliverConnection(controller, peerDeliverFilteredMethod, &requests, []any{
+			&pb.DeliverResponse{Type: &pb.DeliverResponse_FilteredBlock{FilteredBlock: filteredBlock}},
+			NewPeerStatusResponse(cb.Status_SUCCESS),
+		})
+		id := NewTestOrgAdmin("org1.example.com", "Org1MSP")
+
+		actual, err := ReadEvents(channel.PeerDeliverFiltered(specCtx, connection, id, "mychannel",
+			channel.WithSeekRange(channel.SeekBlock(5), channel.SeekBlock(5)),
+		))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(actual).To(HaveLen(1))
+		Expect(actual[0].Number).To(Equal(uint64(5)))
+		Expect(actual[0].Block).To(BeNil())
+		Expect(actual[0].Transactions).To(HaveLen(1))
+		Expect(actual[0].Transactions[0].TxID).To(Equal("TX_ID"))
+		Expect(actual[0].Transactions[0].Type).To(Equal(cb.HeaderType_ENDORSER_TRANSACTION))
+		Expect(actual[0].Transactions[0].ValidationCode).To(Equal(pb.TxValidationCode_MVCC_READ_CONFLICT))
+		Expect(actual[0].Transactions[0].ChaincodeEvents).To(HaveLen(1))
+		Expect(actual[0].Transactions[0].ChaincodeEvents[0].GetEventName()).To(Equal("EVENT"))
+	})
+
+	It("Block events with private data", func(specCtx SpecContext) {
+		privateData := map[uint64]*rwset.TxPvtReadWriteSet{
+			0: {
+				DataModel: rwset.TxReadWriteSet_KV,
+				NsPvtRwset: []*rwset.NsPvtReadWriteSet{
+					{Namespace: "basic"},
+				},
+			},
+		}
+
+		controller := gomock.NewController(GinkgoT())
+		connection := NewMockDeliverConnection(controller, peerDeliverWithPrivateDataMethod, &requests, []any{
+			&pb.DeliverResponse{
+				Type: &pb.DeliverResponse_BlockAndPrivateData{
+					BlockAndPrivateData: &pb.BlockAndPrivateData{
+						Block:          NewCommittedBlock(5),
+						PrivateDataMap: privateData,
+					},
+				},
+			},
+			NewPeerStatusResponse(cb.Status_SUCCESS),
+		})
+		id := NewTestOrgAdmin("org1.example.com", "Org1MSP")
+
+		actual, err := ReadEvents(channel.PeerDeliverWithPrivateData(specCtx, connection, id, "mychannel",
+			channel.WithSeekRange(channel.SeekBlock(5), channel.SeekBlock(5)),
+		))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(actual).To(HaveLen(1))
+		Expect(actual[0].Number).To(Equal(uint64(5)))
+		Expect(actual[0].Transactions).To(HaveLen(2))
+		Expect(actual[0].PrivateData).To(HaveKey(uint64(0)))
+		Expect(actual[0].PrivateData[0].GetNsPvtRwset()[0].GetNamespace()).To(Equal("basic"))
+	})
+
+	It("Status error returned", func(specCtx SpecContext) {
+		controller := gomock.NewController(GinkgoT())
+		connection := NewMockDeliverConnection(controller, peerDeliverMethod, &requests, []any{
+			NewPeerStatusResponse(cb.Status_FORBIDDEN),
+		})
+		id := NewTestOrgAdmin("org1.example.com", "Org1MSP")
+
+		_, err := ReadEvents(channel.PeerDeliver(specCtx, connection, id, "mychannel"))
+
+		var statusErr *channel.DeliverStatusError
+		Expect(errors.As(err, &statusErr)).To(BeTrue(), "Expected DeliverStatusError, got %v", err)
+		Expect(statusErr.Status).To(Equal(cb.Status_FORBIDDEN))
+	})
+
+	It("Invalid block returns error", func(specCtx SpecContext) {
+		invalidBlock := NewCommittedBlock(5)
+		invalidBlock.Data.Data = [][]byte{[]byte("INVALID")}
+
+		controller := gomock.NewController(GinkgoT())
+		connection := NewMockDeliverConnection(controller, peerDeliverMethod, &requests, []any{
+			&pb.DeliverResponse{Type: &pb.DeliverResponse_Block{Block: invalidBlock}},
+		})
+		id := NewTestOrgAdmin("org1.example.com", "Org1MSP")
+
+		_, err := ReadEvents(channel.PeerDeliver(specCtx, connection, id, "mychannel"))
+
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,132 @@
+package channel
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hyperledger/fabric-admin-sdk/internal/configtxgen/encoder"
+	"github.com/hyperledger/fabric-admin-sdk/internal/configtxgen/genesisconfig"
+	"github.com/hyperledger/fabric-admin-sdk/pkg/chaincode"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"github.com/hyperledger/fabric-protos-go-apiv2/msp"
+	"google.golang.org/protobuf/proto"
+)
+
+// GetPolicy returns the policy at the specified absolute path, such as "/Channel/Application/Org1MSP/Endorsement",
+// in human-readable form.
+func GetPolicy(config *cb.Config, policyPath string) (*Policy, error) {
+	configPolicy, err := findPolicy(config, policyPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return DecodePolicy(configPolicy.GetPolicy())
+}
+
+// DecodePolicy converts a policy from channel configuration into human-readable form. Signature policies are
+// expressed using the same syntax as chaincode endorsement policies, for example "OR('Org1MSP.admin')", and implicit
+// meta policies are expressed as a rule and sub-policy, for example "MAJORITY Admins". Signature policies that refer
+// to principals other than MSP roles, such as the BFT BlockValidation policy, cannot be decoded.
+func DecodePolicy(policy *cb.Policy) (*Policy, error) {
+	switch cb.Policy_PolicyType(policy.GetType()) {
+	case cb.Policy_SIGNATURE:
+		envelope := &cb.SignaturePolicyEnvelope{}
+		if err := proto.Unmarshal(policy.GetValue(), envelope); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal signature policy: %w", err)
+		}
+
+		for _, principal := range envelope.GetIdentities() {
+			if principal.GetPrincipalClassification() != msp.MSPPrincipal_ROLE {
+				return nil, fmt.Errorf("cannot decode signature policy with %s principal", principal.GetPrincipalClassification())
+			}
+		}
+
+		rule, err := chaincode.SignaturePolicyEnvelopeToString(envelope)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode signature policy: %w", err)
+		}
+
+		return &Policy{Type: SignaturePolicyType, Rule: rule}, nil
+
+	case cb.Policy_IMPLICIT_META:
+		implicitMeta := &cb.ImplicitMetaPolicy{}
+		if err := proto.Unmarshal(policy.GetValue(), implicitMeta); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal implicit meta policy: %w", err)
+		}
+
+		return &Policy{
+			Type: ImplicitMetaPolicyType,
+			Rule: implicitMeta.GetRule().String() + " " + implicitMeta.GetSubPolicy(),
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported policy type: %s", cb.Policy_PolicyType(policy.GetType()))
+	}
+}
+
+// SetPolicy creates an unsigned config update that sets the policy at the specified absolute path, such as
+// "/Channel/Application/Org1MSP/Endorsement" or "/Channel/Orderer/Admins". Signature policy rules are parsed from
+// the policy DSL, for example "OR('Org1MSP.admin', 'Org2MSP.admin')", and implicit meta policy rules take the form
+// "MAJORITY Admins". A replaced policy retains its existing mod_policy; a new policy uses the Admins policy of its
+// config group.
+func SetPolicy(channelID string, current *cb.Config, policyPath string, policy *Policy) (*ConfigUpdate, error) {
+	if policy == nil {
+		return nil, errors.New("policy is required")
+	}
+
+	groupPath, policyName, err := splitAbsolutePolicyPath(policyPath)
+	if err != nil {
+		return nil, err
+	}
+
+	configPolicy, err := encoder.NewConfigPolicy(&genesisconfig.Policy{Type: policy.Type, Rule: policy.Rule}, AdminsPolicyKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return newConfigUpdate(channelID, current, func(config *cb.Config) error {
+		group, err := findGroup(config.GetChannelGroup(), groupPath...)
+		if err != nil {
+			return err
+		}
+
+		if existing, ok := group.GetPolicies()[policyName]; ok {
+			configPolicy.ModPolicy = existing.GetModPolicy()
+		}
+
+		if group.Policies == nil {
+			group.Policies = make(map[string]*cb.ConfigPolicy)
+		}
+		group.Policies[policyName] = configPolicy
+
+		return nil
+	})
+}
+
+func findPolicy(config *cb.Config, policyPath string) (*cb.ConfigPolicy, error) {
+	groupPath, policyName, err := splitAbsolutePolicyPath(policyPath)
+	if err != nil {
+		return nil, err
+	}
+
+	group, err := findGroup(config.GetChannelGroup(), groupPath...)
+	if err != nil {
+		return nil, err
+	}
+
+	result, ok := group.GetPolicies()[policyName]
+	if !ok {
+		return nil, fmt.Errorf("policy %s %w", policyPath, errNotFound)
+	}
+
+	return result, nil
+}
+
+func splitAbsolutePolicyPath(policyPath string) ([]string, string, error) {
+	if len(policyPath) == 0 || policyPath[0] != '/' {
+		return nil, "", fmt.Errorf("policy path must be absolute: %q", policyPath)
+	}
+
+	return splitPolicyRef(policyPath)
+}
@@ -0,0 +1,88 @@
+package channel_test
+
+import (
+	"github.com/hyperledger/fabric-admin-sdk/pkg/channel"
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Policy", func() {
+	var config *cb.Config
+
+	BeforeEach(func() {
+		config = NewTestConfig()
+	})
+
+	It("Reads signature policy", func() {
+		policy, err := channel.GetPolicy(config, "/Channel/Application/Org1MSP/Readers")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policy).To(Equal(&channel.Policy{
+			Type: channel.SignaturePolicyType,
+			Rule: "OR('Org1MSP.admin','Org1MSP.peer','Org1MSP.client')",
+		}))
+	})
+
+	It("Reads implicit meta policy", func() {
+		policy, err := channel.GetPolicy(config, "/Channel/Orderer/Admins")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policy).To(Equal(&channel.Policy{
+			Type: channel.ImplicitMetaPolicyType,
+			Rule: "MAJORITY Admins",
+		}))
+	})
+
+	It("Fails to read policy that does not exist", func() {
+		_, err := channel.GetPolicy(config, "/Channel/Application/Org3MSP/Readers")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("Fails to read BFT block validation policy", func() {
+		_, err := channel.GetPolicy(NewTestBFTConfig(4), "/Channel/Orderer/BlockValidation")
+		Expect(err).To(MatchError(ContainSubstring("IDENTITY")))
+	})
+
+	It("Replaces signature policy", func() {
+		configUpdate, err := channel.SetPolicy("mychannel", config, "/Channel/Application/Org1MSP/Endorsement", &channel.Policy{
+			Type: channel.SignaturePolicyType,
+			Rule: "AND('Org1MSP.peer', 'Org1MSP.admin')",
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		orgGroup := AssertConfigUpdate(configUpdate).GetWriteSet().GetGroups()[channel.ApplicationGroupKey].GetGroups()["Org1MSP"]
+		configPolicy := orgGroup.GetPolicies()[channel.EndorsementPolicyKey]
+		Expect(configPolicy.GetVersion()).To(BeEquivalentTo(1))
+		Expect(configPolicy.GetModPolicy()).To(Equal(channel.AdminsPolicyKey))
+
+		policy, err := channel.DecodePolicy(configPolicy.GetPolicy())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policy.Rule).To(Equal("AND('Org1MSP.peer','Org1MSP.admin')"))
+	})
+
+	It("Replaces implicit meta policy", func() {
+		configUpdate, err := channel.SetPolicy("mychannel", config, "/Channel/Application/Admins", &channel.Policy{
+			Type: channel.ImplicitMetaPolicyType,
+			Rule: "ANY Admins",
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		applicationGroup := AssertConfigUpdate(configUpdate).GetWriteSet().GetGroups()[channel.ApplicationGroupKey]
+		policy, err := channel.DecodePolicy(applicationGroup.GetPolicies()[channel.AdminsPolicyKey].GetPolicy())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policy.Rule).To(Equal("ANY Admins"))
+	})
+
+	DescribeTable("Rejects invalid policy",
+		func(policyPath string, policy *channel.Policy) {
+			_, err := channel.SetPolicy("mychannel", config, policyPath, policy)
+			Expect(err).To(HaveOccurred())
+		},
+		Entry("Relative path", "Admins", &channel.Policy{Type: channel.ImplicitMetaPolicyType, Rule: "ANY Admins"}),
+		Entry("Unknown group", "/Channel/Application/Org3MSP/Admins", &channel.Policy{Type: channel.ImplicitMetaPolicyType, Rule: "ANY Admins"}),
+		Entry("Invalid DSL", "/Channel/Application/Org1MSP/Admins", &channel.Policy{Type: channel.SignaturePolicyType, Rule: "OR('Org1MSP.admin'"}),
+		Entry("Invalid implicit meta rule", "/Channel/Application/Admins", &channel.Policy{Type: channel.ImplicitMetaPolicyType, Rule: "SOME Admins"}),
+		Entry("Unknown type", "/Channel/Application/Admins", &channel.Policy{Type: "Other", Rule: "ANY Admins"}),
+		Entry("Nil policy", "/Channel/Application/Admins", nil),
+		Entry("Empty type", "/Channel/Application/Admins", &channel.Policy{Rule: "ANY Admins"}),
+	)
+})
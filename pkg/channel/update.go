@@ -0,0 +1,148 @@
+package channel
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hyperledger/fabric-admin-sdk/internal/configtxlator/update"
+	"github.com/hyperledger/fabric-admin-sdk/internal/protoutil"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"google.golang.org/protobuf/proto"
+)
+
+// ConfigFromBlock extracts the channel configuration from a config block, such as the block returned by
+// GetConfigBlock or GetConfigBlockFromOrderer.
+func ConfigFromBlock(block *cb.Block) (*cb.Config, error) {
+	envelope, err := configEnvelopeFromBlock(block)
+	if err != nil {
+		return nil, err
+	}
+
+	if envelope.GetConfig().GetChannelGroup() == nil {
+		return nil, errors.New("config block contains no channel group")
+	}
+
+	return envelope.GetConfig(), nil
+}
+
+// ChannelIDFromBlock returns the ID of the channel to which a block belongs.
+func ChannelIDFromBlock(block *cb.Block) (string, error) {
+	payload, err := firstPayloadFromBlock(block)
+	if err != nil {
+		return "", err
+	}
+
+	channelHeader, err := protoutil.UnmarshalChannelHeader(payload.GetHeader().GetChannelHeader())
+	if err != nil {
+		return "", err
+	}
+
+	return channelHeader.GetChannelId(), nil
+}
+
+// ComputeConfigUpdate computes the config update required to move a channel from its original configuration to
+// the updated configuration. Neither of the supplied configurations is modified.
+func ComputeConfigUpdate(channelID string, original, updated *cb.Config) (*cb.ConfigUpdate, error) {
+	configUpdate, err := update.Compute(original, updated)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute config update: %w", err)
+	}
+
+	configUpdate.ChannelId = channelID
+	return configUpdate, nil
+}
+
+// NewConfigUpdateEnvelope computes the config update required to move a channel from its original configuration to
+// the updated configuration, and wraps it in a config update envelope containing no signatures.
+func NewConfigUpdateEnvelope(channelID string, original, updated *cb.Config) (*cb.ConfigUpdateEnvelope, error) {
+	configUpdate, err := ComputeConfigUpdate(channelID, original, updated)
+	if err != nil {
+		return nil, err
+	}
+
+	configUpdateBytes, err := proto.Marshal(configUpdate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal config update: %w", err)
+	}
+
+	return &cb.ConfigUpdateEnvelope{
+		ConfigUpdate: configUpdateBytes,
+	}, nil
+}
+
+// ConfigModifier applies changes to a copy of a channel configuration.
+type ConfigModifier func(config *cb.Config) error
+
+// UpdateConfig applies the supplied modifications to a copy of the current channel configuration, and returns a
+// config update envelope describing the changes. The current configuration is not modified.
+func UpdateConfig(channelID string, current *cb.Config, modifiers ...ConfigModifier) (*cb.ConfigUpdateEnvelope, error) {
+	updated, ok := proto.Clone(current).(*cb.Config)
+	if !ok {
+		return nil, errors.New("failed to copy channel config")
+	}
+
+	for _, modify := range modifiers {
+		if err := modify(updated); err != nil {
+			return nil, err
+		}
+	}
+
+	return NewConfigUpdateEnvelope(channelID, current, updated)
+}
+
+// newConfigUpdate applies the supplied modifications to a copy of the current channel configuration, and returns an
+// unsigned config update describing the changes.
+func newConfigUpdate(channelID string, current *cb.Config, modifiers ...ConfigModifier) (*ConfigUpdate, error) {
+	envelope, err := UpdateConfig(channelID, current, modifiers...)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewConfigUpdate(envelope), nil
+}
+
+func configEnvelopeFromBlock(block *cb.Block) (*cb.ConfigEnvelope, error) {
+	payload, err := firstPayloadFromBlock(block)
+	if err != nil {
+		return nil, err
+	}
+
+	channelHeader, err := protoutil.UnmarshalChannelHeader(payload.GetHeader().GetChannelHeader())
+	if err != nil {
+		return nil, err
+	}
+
+	if channelHeader.GetType() != int32(cb.HeaderType_CONFIG) {
+		return nil, fmt.Errorf("block is not a config block, header type: %s", cb.HeaderType(channelHeader.GetType()))
+	}
+
+	envelope := &cb.ConfigEnvelope{}
+	if err := proto.Unmarshal(payload.GetData(), envelope); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal config envelope: %w", err)
+	}
+
+	return envelope, nil
+}
+
+func firstPayloadFromBlock(block *cb.Block) (*cb.Payload, error) {
+	if len(block.GetData().GetData()) == 0 {
+		return nil, errors.New("block contains no data")
+	}
+
+	envelope, err := protoutil.UnmarshalEnvelope(block.GetData().GetData()[0])
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := protoutil.UnmarshalPayload(envelope.GetPayload())
+	if err != nil {
+		return nil, err
+	}
+
+	if payload.GetHeader() == nil {
+		return nil, errors.New("block envelope contains no header")
+	}
+
+	return payload, nil
+}
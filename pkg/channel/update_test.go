@@ -0,0 +1,91 @@
+package channel_test
+
+import (
+	"github.com/hyperledger/fabric-admin-sdk/internal/configtxgen/encoder"
+	"github.com/hyperledger/fabric-admin-sdk/internal/genesis"
+	"github.com/hyperledger/fabric-admin-sdk/pkg/channel"
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	ab "github.com/hyperledger/fabric-protos-go-apiv2/orderer"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+)
+
+func AssertMarshal(m proto.Message) []byte {
+	result, err := proto.Marshal(m)
+	Expect(err).NotTo(HaveOccurred())
+	return result
+}
+
+func NewTestConfigBlock(channelID string) *cb.Block {
+	channelGroup := encoder.NewConfigGroup()
+	channelGroup.ModPolicy = "Admins"
+
+	ordererGroup := encoder.NewConfigGroup()
+	ordererGroup.ModPolicy = "Admins"
+	ordererGroup.Values["BatchSize"] = &cb.ConfigValue{
+		ModPolicy: "Admins",
+		Value:     AssertMarshal(&ab.BatchSize{MaxMessageCount: 10, AbsoluteMaxBytes: 1024, PreferredMaxBytes: 512}),
+	}
+	channelGroup.Groups["Orderer"] = ordererGroup
+
+	return genesis.NewFactoryImpl(channelGroup).Block(channelID)
+}
+
+var _ = Describe("Config update", func() {
+	var block *cb.Block
+
+	BeforeEach(func() {
+		block = NewTestConfigBlock("mychannel")
+	})
+
+	It("Reads config from config block", func() {
+		config, err := channel.ConfigFromBlock(block)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(config.GetChannelGroup().GetGroups()).To(HaveKey("Orderer"))
+	})
+
+	It("Reads channel ID from block", func() {
+		channelID, err := channel.ChannelIDFromBlock(block)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(channelID).To(Equal("mychannel"))
+	})
+
+	It("Fails to read config from empty block", func() {
+		_, err := channel.ConfigFromBlock(&cb.Block{})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("Computes config update envelope for modified config", func() {
+		config, err := channel.ConfigFromBlock(block)
+		Expect(err).NotTo(HaveOccurred())
+
+		envelope, err := channel.UpdateConfig("mychannel", config, func(updated *cb.Config) error {
+			updated.ChannelGroup.Groups["Orderer"].Values["BatchSize"].Value = AssertMarshal(&ab.BatchSize{MaxMessageCount: 20})
+			return nil
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(envelope.GetSignatures()).To(BeEmpty())
+
+		configUpdate := &cb.ConfigUpdate{}
+		Expect(proto.Unmarshal(envelope.GetConfigUpdate(), configUpdate)).To(Succeed())
+		Expect(configUpdate.GetChannelId()).To(Equal("mychannel"))
+
+		batchSize := configUpdate.GetWriteSet().GetGroups()["Orderer"].GetValues()["BatchSize"]
+		Expect(batchSize.GetVersion()).To(Equal(uint64(1)))
+
+		original, err := channel.ConfigFromBlock(block)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(proto.Equal(original, config)).To(BeTrue(), "current config should not be modified")
+	})
+
+	It("Fails when config is unchanged", func() {
+		config, err := channel.ConfigFromBlock(block)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = channel.UpdateConfig("mychannel", config)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,235 @@
+package channel
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/hyperledger/fabric-admin-sdk/internal/protoutil"
+	"github.com/hyperledger/fabric-admin-sdk/internal/util"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"github.com/hyperledger/fabric-protos-go-apiv2/msp"
+	"google.golang.org/protobuf/proto"
+)
+
+// BlockVerifier verifies that blocks in a channel ledger are intact and were created by the ordering service. Blocks
+// must be verified in ledger order. Each block is checked against the channel configuration in effect when it was
+// created, so verifying a config block updates the configuration used to verify subsequent blocks.
+type BlockVerifier struct {
+	evaluator  *policyEvaluator
+	consenters []*cb.Consenter
+	previous   *cb.BlockHeader
+}
+
+// NewBlockVerifier creates a block verifier using the channel configuration in effect for the first block to be
+// verified. This is typically the configuration from the genesis block, or from the most recent config block
+// preceding the first block to be verified.
+func NewBlockVerifier(config *cb.Config) (*BlockVerifier, error) {
+	result := &BlockVerifier{}
+	if err := result.setConfig(config); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Verify checks that a block's data matches the data hash in its header, that its header hash chains to the
+// previously verified block, and that its signatures satisfy the /Channel/Orderer/BlockValidation policy. The genesis
+// block is not signed, so its signatures are not checked.
+func (v *BlockVerifier) Verify(block *cb.Block) error {
+	header := block.GetHeader()
+	if header == nil {
+		return errors.New("block contains no header")
+	}
+
+	if err := v.verify(block); err != nil {
+		return fmt.Errorf("block %d: %w", header.GetNumber(), err)
+	}
+
+	v.previous = header
+	return nil
+}
+
+// Verified returns the blocks from a sequence, such as one returned by Deliver, after verifying each one. Iteration
+// ends with an error if a block fails verification.
+func (v *BlockVerifier) Verified(blocks iter.Seq2[*cb.Block, error]) iter.Seq2[*cb.Block, error] {
+	return func(yield func(*cb.Block, error) bool) {
+		for block, err := range blocks {
+			if err == nil {
+				err = v.Verify(block)
+			}
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			if !yield(block, nil) {
+				return
+			}
+		}
+	}
+}
+
+func (v *BlockVerifier) verify(block *cb.Block) error {
+	header := block.GetHeader()
+
+	if err := v.verifyHashChain(header); err != nil {
+		return err
+	}
+
+	if !bytes.Equal(protoutil.BlockDataHash(block.GetData()), header.GetDataHash()) {
+		return errors.New("data hash does not match block data")
+	}
+
+	if header.GetNumber() > 0 {
+		if err := v.verifySignatures(block); err != nil {
+			return err
+		}
+	}
+
+	config, err := configFromVerifiedBlock(block)
+	if err != nil {
+		return err
+	}
+	if config != nil {
+		return v.setConfig(config)
+	}
+
+	return nil
+}
+
+func (v *BlockVerifier) verifyHashChain(header *cb.BlockHeader) error {
+	if v.previous == nil {
+		return nil
+	}
+
+	if header.GetNumber() != v.previous.GetNumber()+1 {
+		return fmt.Errorf("expected block number %d", v.previous.GetNumber()+1)
+	}
+
+	if !bytes.Equal(header.GetPreviousHash(), protoutil.BlockHeaderHash(v.previous)) {
+		return fmt.Errorf("previous hash does not match header hash of block %d", v.previous.GetNumber())
+	}
+
+	return nil
+}
+
+func (v *BlockVerifier) verifySignatures(block *cb.Block) error {
+	metadata, err := getMetadataFromBlock(block, cb.BlockMetadataIndex_SIGNATURES)
+	if err != nil {
+		return err
+	}
+
+	signatures, err := v.blockSignedData(metadata, block.GetHeader())
+	if err != nil {
+		return err
+	}
+
+	identities := validIdentities(signatures, v.evaluator.msps)
+	if ok, missing := v.evaluator.evaluate([]string{OrdererGroupKey}, BlockValidationPolicyKey, identities); !ok {
+		policyPath := groupPathString([]string{OrdererGroupKey}) + "/" + BlockValidationPolicyKey
+		return fmt.Errorf("signatures do not satisfy %s policy, missing signatures from: %s", policyPath, strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+// blockSignedData returns the signatures over a block. Each signer is identified either by a signature header
+// containing its identity, or, for BFT orderers, by an identifier header containing its consenter ID.
+func (v *BlockVerifier) blockSignedData(metadata *cb.Metadata, header *cb.BlockHeader) ([]*signedData, error) {
+	headerBytes := protoutil.BlockHeaderBytes(header)
+
+	var result []*signedData
+	for _, signature := range metadata.GetSignatures() {
+		if len(signature.GetSignatureHeader()) == 0 && len(signature.GetIdentifierHeader()) > 0 {
+			identity, err := v.consenterIdentity(signature.GetIdentifierHeader())
+			if err != nil {
+				return nil, err
+			}
+			if identity == nil {
+				continue
+			}
+
+			result = append(result, &signedData{
+				data:      util.Concatenate(metadata.GetValue(), signature.GetIdentifierHeader(), headerBytes),
+				identity:  identity,
+				signature: signature.GetSignature(),
+			})
+			continue
+		}
+
+		signatureHeader, err := protoutil.UnmarshalSignatureHeader(signature.GetSignatureHeader())
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, &signedData{
+			data:      util.Concatenate(metadata.GetValue(), signature.GetSignatureHeader(), headerBytes),
+			identity:  signatureHeader.GetCreator(),
+			signature: signature.GetSignature(),
+		})
+	}
+
+	return result, nil
+}
+
+// consenterIdentity returns the serialized identity of the consenter identified by an identifier header, or nil if
+// there is no such consenter.
+func (v *BlockVerifier) consenterIdentity(identifierHeaderBytes []byte) ([]byte, error) {
+	identifierHeader := &cb.IdentifierHeader{}
+	if err := proto.Unmarshal(identifierHeaderBytes, identifierHeader); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal identifier header: %w", err)
+	}
+
+	for _, consenter := range v.consenters {
+		if consenter.GetId() != identifierHeader.GetIdentifier() {
+			continue
+		}
+
+		return proto.Marshal(&msp.SerializedIdentity{
+			Mspid:   consenter.GetMspId(),
+			IdBytes: consenter.GetIdentity(),
+		})
+	}
+
+	return nil, nil
+}
+
+func (v *BlockVerifier) setConfig(config *cb.Config) error {
+	evaluator, err := newPolicyEvaluator(config)
+	if err != nil {
+		return err
+	}
+
+	orderers := &cb.Orderers{}
+	ordererGroup := config.GetChannelGroup().GetGroups()[OrdererGroupKey]
+	if err := unmarshalValue(ordererGroup, OrderersKey, orderers); err != nil && !errors.Is(err, errNotFound) {
+		return err
+	}
+
+	v.evaluator = evaluator
+	v.consenters = orderers.GetConsenterMapping()
+	return nil
+}
+
+// configFromVerifiedBlock returns the channel configuration contained in a config block, or nil for other blocks.
+func configFromVerifiedBlock(block *cb.Block) (*cb.Config, error) {
+	payload, err := firstPayloadFromBlock(block)
+	if err != nil {
+		return nil, err
+	}
+
+	channelHeader, err := protoutil.UnmarshalChannelHeader(payload.GetHeader().GetChannelHeader())
+	if err != nil {
+		return nil, err
+	}
+
+	if channelHeader.GetType() != int32(cb.HeaderType_CONFIG) {
+		return nil, nil
+	}
+
+	return ConfigFromBlock(block)
+}
@@ -0,0 +1,286 @@
+package channel_test
+
+import (
+	"path/filepath"
+
+	"github.com/hyperledger/fabric-admin-sdk/internal/protoutil"
+	"github.com/hyperledger/fabric-admin-sdk/pkg/channel"
+	"github.com/hyperledger/fabric-admin-sdk/pkg/identity"
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"github.com/hyperledger/fabric-protos-go-apiv2/msp"
+	pb "github.com/hyperledger/fabric-protos-go-apiv2/peer"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+)
+
+func NewTestOrderer() identity.SigningIdentity {
+	mspDir := filepath.Join(testDataDir, "ordererOrganizations", "example.com", "orderers", "orderer.example.com", "msp")
+
+	certificate, err := identity.ReadCertificate(filepath.Join(mspDir, "signcerts", "orderer.example.com-cert.pem"))
+	Expect(err).NotTo(HaveOccurred())
+
+	privateKey, err := identity.ReadPrivateKey(filepath.Join(mspDir, "keystore", "priv_sk"))
+	Expect(err).NotTo(HaveOccurred())
+
+	result, err := identity.NewPrivateKeySigningIdentity("OrdererMSP", certificate, privateKey)
+	Expect(err).NotTo(HaveOccurred())
+	return result
+}
+
+// NewNextBlock creates a block following the previous block, containing the supplied data, with no signatures.
+func NewNextBlock(previous *cb.Block, data ...[]byte) *cb.Block {
+	blockData := &cb.BlockData{Data: data}
+
+	return &cb.Block{
+		Header: &cb.BlockHeader{
+			Number:       previous.GetHeader().GetNumber() + 1,
+			PreviousHash: protoutil.BlockHeaderHash(previous.GetHeader()),
+			DataHash:     protoutil.BlockDataHash(blockData),
+		},
+		Data: blockData,
+		Metadata: &cb.BlockMetadata{
+			Metadata: make([][]byte, len(cb.BlockMetadataIndex_name)),
+		},
+	}
+}
+
+// SignBlock adds a signature to a block, identifying the signer with a signature header.
+func SignBlock(block *cb.Block, signer identity.SigningIdentity) {
+	signatureHeader := AssertMarshal(&cb.SignatureHeader{
+		Creator: AssertMarshal(&msp.SerializedIdentity{
+			Mspid:   signer.MspID(),
+			IdBytes: signer.Credentials(),
+		}),
+		Nonce: []byte("NONCE"),
+	})
+
+	addBlockSignature(block, signer, &cb.MetadataSignature{SignatureHeader: signatureHeader})
+}
+
+// SignBFTBlock adds a signature to a block, identifying the signer with a BFT consenter ID.
+func SignBFTBlock(block *cb.Block, signer identity.SigningIdentity, consenterID uint32) {
+	identifierHeader := AssertMarshal(&cb.IdentifierHeader{
+		Identifier: consenterID,
+		Nonce:      []byte("NONCE"),
+	})
+
+	addBlockSignature(block, signer, &cb.MetadataSignature{IdentifierHeader: identifierHeader})
+}
+
+func addBlockSignature(block *cb.Block, signer identity.SigningIdentity, signature *cb.MetadataSignature) {
+	metadata := &cb.Metadata{}
+	Expect(proto.Unmarshal(block.GetMetadata().GetMetadata()[cb.BlockMetadataIndex_SIGNATURES], metadata)).To(Succeed())
+
+	header := signature.GetSignatureHeader()
+	if len(header) == 0 {
+		header = signature.GetIdentifierHeader()
+	}
+
+	var signedData []byte
+	signedData = append(signedData, metadata.GetValue()...)
+	signedData = append(signedData, header...)
+	signedData = append(signedData, protoutil.BlockHeaderBytes(block.GetHeader())...)
+
+	var err error
+	signature.Signature, err = signer.Sign(signedData)
+	Expect(err).NotTo(HaveOccurred())
+
+	metadata.Signatures = append(metadata.Signatures, signature)
+	block.Metadata.Metadata[cb.BlockMetadataIndex_SIGNATURES] = AssertMarshal(metadata)
+}
+
+func NewTransactionEnvelope() []byte {
+	return NewEndorserTransactionEnvelope("TX_ID", &pb.ChaincodeEvent{})
+}
+
+func NewConfigEnvelope(config *cb.Config) []byte {
+	payload := &cb.Payload{
+		Header: &cb.Header{
+			ChannelHeader: AssertMarshal(&cb.ChannelHeader{
+				Type:      int32(cb.HeaderType_CONFIG),
+				ChannelId: "mychannel",
+			}),
+			SignatureHeader: AssertMarshal(&cb.SignatureHeader{}),
+		},
+		Data: AssertMarshal(&cb.ConfigEnvelope{Config: config}),
+	}
+
+	return AssertMarshal(&cb.Envelope{Payload: AssertMarshal(payload)})
+}
+
+func NewBlockVerifier(genesisBlock *cb.Block) *channel.BlockVerifier {
+	config, err := channel.ConfigFromBlock(genesisBlock)
+	Expect(err).NotTo(HaveOccurred())
+
+	result, err := channel.NewBlockVerifier(config)
+	Expect(err).NotTo(HaveOccurred())
+	return result
+}
+
+var _ = Describe("BlockVerifier", func() {
+	var orderer identity.SigningIdentity
+	var genesisBlock *cb.Block
+
+	BeforeEach(func() {
+		orderer = NewTestOrderer()
+		genesisBlock = NewTestGenesisBlock("mychannel")
+	})
+
+	It("Verifies blocks signed by orderer", func() {
+		block1 := NewNextBlock(genesisBlock, NewEndorserTransactionEnvelope("TX_ID_1", &pb.ChaincodeEvent{}))
+		SignBlock(block1, orderer)
+		block2 := NewNextBlock(block1, NewEndorserTransactionEnvelope("TX_ID_2", &pb.ChaincodeEvent{}))
+		SignBlock(block2, orderer)
+
+		verifier := NewBlockVerifier(genesisBlock)
+
+		Expect(verifier.Verify(genesisBlock)).To(Succeed())
+		Expect(verifier.Verify(block1)).To(Succeed())
+		Expect(verifier.Verify(block2)).To(Succeed())
+	})
+
+	It("Verification can start after genesis block", func() {
+		block1 := NewNextBlock(genesisBlock, NewTransactionEnvelope())
+		SignBlock(block1, orderer)
+		block2 := NewNextBlock(block1, NewTransactionEnvelope())
+		SignBlock(block2, orderer)
+
+		verifier := NewBlockVerifier(genesisBlock)
+
+		Expect(verifier.Verify(block1)).To(Succeed())
+		Expect(verifier.Verify(block2)).To(Succeed())
+	})
+
+	It("Fails if block data does not match data hash", func() {
+		block1 := NewNextBlock(genesisBlock, NewTransactionEnvelope())
+		SignBlock(block1, orderer)
+		block1.Data.Data = [][]byte{[]byte("TAMPERED")}
+
+		verifier := NewBlockVerifier(genesisBlock)
+
+		Expect(verifier.Verify(block1)).To(MatchError(ContainSubstring("block 1: data hash")))
+	})
+
+	It("Fails if previous hash does not match previous block", func() {
+		block1 := NewNextBlock(genesisBlock, NewTransactionEnvelope())
+		SignBlock(block1, orderer)
+		block2 := NewNextBlock(block1, NewTransactionEnvelope())
+		block2.Header.PreviousHash = []byte("WRONG_HASH")
+		SignBlock(block2, orderer)
+
+		verifier := NewBlockVerifier(genesisBlock)
+		Expect(verifier.Verify(block1)).To(Succeed())
+
+		Expect(verifier.Verify(block2)).To(MatchError(ContainSubstring("block 2: previous hash")))
+	})
+
+	It("Fails if block number does not follow previous block", func() {
+		block1 := NewNextBlock(genesisBlock, NewTransactionEnvelope())
+		SignBlock(block1, orderer)
+		block2 := NewNextBlock(block1, NewTransactionEnvelope())
+		SignBlock(block2, orderer)
+
+		verifier := NewBlockVerifier(genesisBlock)
+		Expect(verifier.Verify(genesisBlock)).To(Succeed())
+
+		Expect(verifier.Verify(block2)).To(MatchError(ContainSubstring("expected block number 1")))
+	})
+
+	It("Fails if block is not signed", func() {
+		block1 := NewNextBlock(genesisBlock, NewTransactionEnvelope())
+		block1.Metadata.Metadata[cb.BlockMetadataIndex_SIGNATURES] = AssertMarshal(&cb.Metadata{})
+
+		verifier := NewBlockVerifier(genesisBlock)
+
+		Expect(verifier.Verify(block1)).To(MatchError(ContainSubstring("/Channel/Orderer/BlockValidation")))
+	})
+
+	It("Fails if block is signed by identity other than orderer", func() {
+		block1 := NewNextBlock(genesisBlock, NewTransactionEnvelope())
+		SignBlock(block1, NewTestOrgAdmin("org1.example.com", "Org1MSP"))
+
+		verifier := NewBlockVerifier(genesisBlock)
+
+		Expect(verifier.Verify(block1)).To(MatchError(ContainSubstring("OrdererMSP.member")))
+	})
+
+	It("Fails if signature does not match block", func() {
+		block1 := NewNextBlock(genesisBlock, NewTransactionEnvelope())
+		SignBlock(block1, orderer)
+		block1.Header.Number = 2
+
+		verifier := NewBlockVerifier(genesisBlock)
+
+		Expect(verifier.Verify(block1)).To(MatchError(ContainSubstring("/Channel/Orderer/BlockValidation")))
+	})
+
+	It("Verifies blocks using updated config from config blocks", func() {
+		config, err := channel.ConfigFromBlock(genesisBlock)
+		Expect(err).NotTo(HaveOccurred())
+
+		// Config block whose BlockValidation policy requires an Org1MSP admin signature
+		updated := proto.Clone(config).(*cb.Config)
+		ordererGroup := updated.GetChannelGroup().GetGroups()[channel.OrdererGroupKey]
+		org1Group := updated.GetChannelGroup().GetGroups()[channel.ApplicationGroupKey].GetGroups()["Org1MSP"]
+		ordererGroup.Policies[channel.BlockValidationPolicyKey].Policy = org1Group.GetPolicies()["Admins"].GetPolicy()
+
+		block1 := NewNextBlock(genesisBlock, NewConfigEnvelope(updated))
+		SignBlock(block1, orderer)
+		block2 := NewNextBlock(block1, NewTransactionEnvelope())
+		SignBlock(block2, orderer)
+
+		verifier := NewBlockVerifier(genesisBlock)
+		Expect(verifier.Verify(block1)).To(Succeed())
+		Expect(verifier.Verify(block2)).To(MatchError(ContainSubstring("Org1MSP.admin")))
+	})
+
+	It("Verifies BFT blocks identified by consenter ID", func() {
+		config := NewTestBFTConfig(1)
+		genesisBlock := NewNextBlock(&cb.Block{}, NewConfigEnvelope(config))
+		genesisBlock.Header = &cb.BlockHeader{DataHash: genesisBlock.GetHeader().GetDataHash()}
+
+		block1 := NewNextBlock(genesisBlock, NewTransactionEnvelope())
+		SignBFTBlock(block1, orderer, 1)
+		block2 := NewNextBlock(block1, NewTransactionEnvelope())
+		SignBFTBlock(block2, orderer, 2)
+
+		verifier, err := channel.NewBlockVerifier(config)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(verifier.Verify(genesisBlock)).To(Succeed())
+		Expect(verifier.Verify(block1)).To(Succeed())
+		Expect(verifier.Verify(block2)).To(MatchError(ContainSubstring("/Channel/Orderer/BlockValidation")))
+	})
+
+	It("Verified blocks returned from block sequence", func() {
+		block1 := NewNextBlock(genesisBlock, NewTransactionEnvelope())
+		SignBlock(block1, orderer)
+		block2 := NewNextBlock(block1, NewTransactionEnvelope())
+		block3 := NewNextBlock(block2, NewTransactionEnvelope())
+		SignBlock(block3, orderer)
+
+		blocks := func(yield func(*cb.Block, error) bool) {
+			for _, block := range []*cb.Block{genesisBlock, block1, block2, block3} {
+				if !yield(block, nil) {
+					return
+				}
+			}
+		}
+
+		verifier := NewBlockVerifier(genesisBlock)
+
+		var verified []uint64
+		var verifyErr error
+		for block, err := range verifier.Verified(blocks) {
+			if err != nil {
+				verifyErr = err
+				break
+			}
+			verified = append(verified, block.GetHeader().GetNumber())
+		}
+
+		Expect(verified).To(Equal([]uint64{0, 1}))
+		Expect(verifyErr).To(MatchError(ContainSubstring("block 2")))
+	})
+})
@@ -0,0 +1,34 @@
+package channel
+
+import (
+	"context"
+
+	"github.com/hyperledger/fabric-admin-sdk/pkg/identity"
+	"github.com/hyperledger/fabric-admin-sdk/pkg/wait"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"google.golang.org/grpc"
+)
+
+// WaitForHeight polls a peer using GetBlockChainInfo, backing off between checks, until its ledger height for the
+// channel reaches at least the specified height, and returns the blockchain information at that point. An error is
+// returned if the query fails or the context is done.
+func WaitForHeight(ctx context.Context, connection grpc.ClientConnInterface, id identity.SigningIdentity, channelID string, height uint64, options ...wait.Option) (*cb.BlockchainInfo, error) {
+	backoff := wait.NewBackoff(options...)
+
+	var result *cb.BlockchainInfo
+	err := backoff.Until(ctx, func(ctx context.Context) (bool, error) {
+		info, err := GetBlockChainInfo(ctx, connection, id, channelID)
+		if err != nil {
+			return false, err
+		}
+
+		result = info
+		return info.GetHeight() >= height, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
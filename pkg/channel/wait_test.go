@@ -0,0 +1,88 @@
+package channel_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/hyperledger/fabric-admin-sdk/pkg/channel"
+	"github.com/hyperledger/fabric-admin-sdk/pkg/identity"
+	"github.com/hyperledger/fabric-admin-sdk/pkg/wait"
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	pb "github.com/hyperledger/fabric-protos-go-apiv2/peer"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// NewMockChainInfoEndorser returns a connection that responds to successive proposals with blockchain information for
+// each of the supplied heights, repeating the last height once all have been used.
+func NewMockChainInfoEndorser(controller *gomock.Controller, heights ...uint64) *MockClientConnInterface {
+	connection := NewMockClientConnInterface(controller)
+	connection.EXPECT().
+		Invoke(gomock.Any(), gomock.Eq(processProposalMethod), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, method string, in *pb.SignedProposal, out *pb.ProposalResponse, opts ...grpc.CallOption) error {
+			height := heights[0]
+			if len(heights) > 1 {
+				heights = heights[1:]
+			}
+
+			response := NewProposalResponse(cb.Status_SUCCESS, "", AssertMarshal(&cb.BlockchainInfo{Height: height}))
+			proto.Merge(out, response)
+			return nil
+		}).
+		AnyTimes()
+
+	return connection
+}
+
+var _ = Describe("WaitForHeight", func() {
+	var id identity.SigningIdentity
+
+	BeforeEach(func() {
+		id = NewTestOrgAdmin("org1.example.com", "Org1MSP")
+	})
+
+	It("Returns when peer reaches height", func(specCtx SpecContext) {
+		controller := gomock.NewController(GinkgoT())
+		connection := NewMockChainInfoEndorser(controller, 1, 3, 5, 6)
+
+		actual, err := channel.WaitForHeight(specCtx, connection, id, "mychannel", 5, wait.WithBackoff(time.Millisecond, 5*time.Millisecond))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(actual.GetHeight()).To(Equal(uint64(5)))
+	})
+
+	It("Returns immediately if peer already exceeds height", func(specCtx SpecContext) {
+		controller := gomock.NewController(GinkgoT())
+		connection := NewMockChainInfoEndorser(controller, 10)
+
+		actual, err := channel.WaitForHeight(specCtx, connection, id, "mychannel", 5)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(actual.GetHeight()).To(Equal(uint64(10)))
+	})
+
+	It("Returns query errors", func(specCtx SpecContext) {
+		controller := gomock.NewController(GinkgoT())
+		var invocations []*pb.ChaincodeInvocationSpec
+		connection := NewMockEndorser(controller, NewProposalResponse(cb.Status_INTERNAL_SERVER_ERROR, "EXPECTED_ERROR", nil), &invocations)
+
+		_, err := channel.WaitForHeight(specCtx, connection, id, "mychannel", 5)
+
+		Expect(err).To(MatchError(ContainSubstring("EXPECTED_ERROR")))
+	})
+
+	It("Ends when context is done", func(specCtx SpecContext) {
+		controller := gomock.NewController(GinkgoT())
+		connection := NewMockChainInfoEndorser(controller, 1)
+
+		ctx, cancel := context.WithTimeout(specCtx, 50*time.Millisecond)
+		defer cancel()
+
+		_, err := channel.WaitForHeight(ctx, connection, id, "mychannel", 5, wait.WithBackoff(time.Millisecond, 5*time.Millisecond))
+
+		Expect(err).To(MatchError(context.DeadlineExceeded))
+	})
+})
@@ -0,0 +1,88 @@
+package discovery
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hyperledger/fabric-admin-sdk/pkg/wait"
+	"github.com/hyperledger/fabric-protos-go-apiv2/discovery"
+	"github.com/hyperledger/fabric-protos-go-apiv2/gossip"
+	"google.golang.org/protobuf/proto"
+)
+
+// WaitForSameHeight polls the discovery service using PeerMembershipQuery, backing off between checks, until every
+// peer in the channel reports the same ledger height, and returns that height. Ledger heights are those published by
+// each peer through gossip. An error is returned if the query fails or the context is done.
+func (p *Peer) WaitForSameHeight(ctx context.Context, channel string, options ...wait.Option) (uint64, error) {
+	backoff := wait.NewBackoff(options...)
+
+	var result uint64
+	err := backoff.Until(ctx, func(ctx context.Context) (bool, error) {
+		members, err := p.PeerMembershipQuery(ctx, channel, nil)
+		if err != nil {
+			return false, err
+		}
+
+		heights, err := ledgerHeights(members)
+		if err != nil {
+			return false, err
+		}
+
+		var same bool
+		result, same = sameHeight(heights)
+		return same, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return result, nil
+}
+
+// ledgerHeights returns the ledger height of each peer in a membership result. Peers that have not published their
+// state for the channel have a height of zero.
+func ledgerHeights(members *discovery.PeerMembershipResult) ([]uint64, error) {
+	var result []uint64
+
+	for _, peers := range members.GetPeersByOrg() {
+		for _, peer := range peers.GetPeers() {
+			height, err := ledgerHeight(peer.GetStateInfo())
+			if err != nil {
+				return nil, err
+			}
+
+			result = append(result, height)
+		}
+	}
+
+	return result, nil
+}
+
+func ledgerHeight(stateInfo *gossip.Envelope) (uint64, error) {
+	if stateInfo == nil {
+		return 0, nil
+	}
+
+	message := &gossip.GossipMessage{}
+	if err := proto.Unmarshal(stateInfo.GetPayload(), message); err != nil {
+		return 0, fmt.Errorf("failed to unmarshal peer state info: %w", err)
+	}
+
+	return message.GetStateInfo().GetProperties().GetLedgerHeight(), nil
+}
+
+// sameHeight returns the height shared by all peers, and whether there is such a height. There is no shared height if
+// there are no peers, or any peer has not published its height.
+func sameHeight(heights []uint64) (uint64, bool) {
+	if len(heights) == 0 || heights[0] == 0 {
+		return 0, false
+	}
+
+	for _, height := range heights[1:] {
+		if height != heights[0] {
+			return 0, false
+		}
+	}
+
+	return heights[0], true
+}
@@ -0,0 +1,148 @@
+package discovery_test
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"time"
+
+	"github.com/hyperledger/fabric-admin-sdk/pkg/discovery"
+	"github.com/hyperledger/fabric-admin-sdk/pkg/identity"
+	"github.com/hyperledger/fabric-admin-sdk/pkg/wait"
+	pdiscovery "github.com/hyperledger/fabric-protos-go-apiv2/discovery"
+	"github.com/hyperledger/fabric-protos-go-apiv2/gossip"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+//go:generate mockgen -destination ./clientconnection_mock_test.go -package ${GOPACKAGE} google.golang.org/grpc ClientConnInterface
+
+const discoverMethod = "/discovery.Discovery/Discover"
+
+func NewTestOrgAdmin(org string, mspID string) identity.SigningIdentity {
+	mspDir := filepath.Join("..", "..", "test", "data", "peerOrganizations", org, "users", "Admin@"+org, "msp")
+
+	certificate, err := identity.ReadCertificate(filepath.Join(mspDir, "signcerts", "Admin@"+org+"-cert.pem"))
+	Expect(err).NotTo(HaveOccurred())
+
+	privateKey, err := identity.ReadPrivateKey(filepath.Join(mspDir, "keystore", "priv_sk"))
+	Expect(err).NotTo(HaveOccurred())
+
+	result, err := identity.NewPrivateKeySigningIdentity(mspID, certificate, privateKey)
+	Expect(err).NotTo(HaveOccurred())
+	return result
+}
+
+func AssertMarshal(message proto.Message) []byte {
+	result, err := proto.Marshal(message)
+	Expect(err).NotTo(HaveOccurred())
+	return result
+}
+
+// NewDiscoveredPeer returns a discovered peer that published the supplied ledger height through gossip.
+func NewDiscoveredPeer(height uint64) *pdiscovery.Peer {
+	message := &gossip.GossipMessage{
+		Content: &gossip.GossipMessage_StateInfo{
+			StateInfo: &gossip.StateInfo{
+				Properties: &gossip.Properties{LedgerHeight: height},
+			},
+		},
+	}
+
+	return &pdiscovery.Peer{
+		StateInfo: &gossip.Envelope{Payload: AssertMarshal(message)},
+	}
+}
+
+// NewMembershipResponse returns a discovery response with peers in two organizations, having the supplied ledger
+// heights.
+func NewMembershipResponse(org1Height uint64, org2Height uint64) *pdiscovery.Response {
+	return &pdiscovery.Response{
+		Results: []*pdiscovery.QueryResult{
+			{
+				Result: &pdiscovery.QueryResult_Members{
+					Members: &pdiscovery.PeerMembershipResult{
+						PeersByOrg: map[string]*pdiscovery.Peers{
+							"Org1MSP": {Peers: []*pdiscovery.Peer{NewDiscoveredPeer(org1Height)}},
+							"Org2MSP": {Peers: []*pdiscovery.Peer{NewDiscoveredPeer(org2Height)}},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// NewMockDiscovery returns a connection that responds to successive discovery requests with each of the supplied
+// responses, repeating the last response once all have been used.
+func NewMockDiscovery(controller *gomock.Controller, responses ...*pdiscovery.Response) *MockClientConnInterface {
+	connection := NewMockClientConnInterface(controller)
+	connection.EXPECT().
+		Invoke(gomock.Any(), gomock.Eq(discoverMethod), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, method string, in *pdiscovery.SignedRequest, out *pdiscovery.Response, opts ...grpc.CallOption) error {
+			response := responses[0]
+			if len(responses) > 1 {
+				responses = responses[1:]
+			}
+
+			proto.Merge(out, response)
+			return nil
+		}).
+		AnyTimes()
+
+	return connection
+}
+
+var _ = Describe("WaitForSameHeight", func() {
+	var id identity.SigningIdentity
+
+	BeforeEach(func() {
+		id = NewTestOrgAdmin("org1.example.com", "Org1MSP")
+	})
+
+	It("Returns height once all peers have same height", func(specCtx SpecContext) {
+		controller := gomock.NewController(GinkgoT())
+		connection := NewMockDiscovery(controller,
+			NewMembershipResponse(5, 0),
+			NewMembershipResponse(5, 3),
+			NewMembershipResponse(6, 5),
+			NewMembershipResponse(6, 6),
+		)
+		peer := discovery.NewPeer(connection, id)
+
+		actual, err := peer.WaitForSameHeight(specCtx, "mychannel", wait.WithBackoff(time.Millisecond, 5*time.Millisecond))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(actual).To(Equal(uint64(6)))
+	})
+
+	It("Returns discovery errors", func(specCtx SpecContext) {
+		expectedErr := errors.New("EXPECTED_ERROR")
+		controller := gomock.NewController(GinkgoT())
+		connection := NewMockClientConnInterface(controller)
+		connection.EXPECT().
+			Invoke(gomock.Any(), gomock.Eq(discoverMethod), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(expectedErr)
+		peer := discovery.NewPeer(connection, id)
+
+		_, err := peer.WaitForSameHeight(specCtx, "mychannel")
+
+		Expect(err).To(MatchError(expectedErr))
+	})
+
+	It("Ends when context is done", func(specCtx SpecContext) {
+		controller := gomock.NewController(GinkgoT())
+		connection := NewMockDiscovery(controller, NewMembershipResponse(5, 4))
+		peer := discovery.NewPeer(connection, id)
+
+		ctx, cancel := context.WithTimeout(specCtx, 50*time.Millisecond)
+		defer cancel()
+
+		_, err := peer.WaitForSameHeight(ctx, "mychannel", wait.WithBackoff(time.Millisecond, 5*time.Millisecond))
+
+		Expect(err).To(MatchError(context.DeadlineExceeded))
+	})
+})
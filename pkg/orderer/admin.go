@@ -0,0 +1,345 @@
+// Package orderer provides a client for the channel participation API of ordering service nodes, which is used to
+// join orderers to channels, inspect their channel membership, and remove them from channels.
+package orderer
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+
+	"github.com/hyperledger/fabric-admin-sdk/internal/protoutil"
+	"github.com/hyperledger/fabric-admin-sdk/pkg/wait"
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"google.golang.org/protobuf/proto"
+)
+
+const channelsPath = "/participation/v1/channels"
+
+// Channel status values reported by the channel participation API.
+const (
+	StatusActive     = "active"
+	StatusOnboarding = "onboarding"
+	StatusInactive   = "inactive"
+	StatusFailed     = "failed"
+)
+
+// Consensus relation values reported by the channel participation API.
+const (
+	ConsensusRelationConsenter     = "consenter"
+	ConsensusRelationFollower      = "follower"
+	ConsensusRelationConfigTracker = "config-tracker"
+	ConsensusRelationOther         = "other"
+)
+
+// ChannelList is the list of channels of which an orderer is a member.
+type ChannelList struct {
+	// SystemChannel is always nil for orderers that do not use a system channel.
+	SystemChannel *ChannelInfoShort  `json:"systemChannel"`
+	Channels      []ChannelInfoShort `json:"channels"`
+}
+
+// ChannelInfoShort identifies a channel of which an orderer is a member.
+type ChannelInfoShort struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// ChannelInfo describes the membership of an orderer in a channel.
+type ChannelInfo struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+
+	// ConsensusRelation of the orderer to the channel, such as ConsensusRelationConsenter.
+	ConsensusRelation string `json:"consensusRelation"`
+
+	// Status of the channel on the orderer, such as StatusActive.
+	Status string `json:"status"`
+
+	// Height of the orderer's ledger for the channel.
+	Height uint64 `json:"height"`
+}
+
+// APIError is returned when the channel participation API responds with an error status.
+type APIError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+
+	// Message is the error message from the response body.
+	Message string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("channel participation API request failed with status %d (%s): %s", e.StatusCode, http.StatusText(e.StatusCode), e.Message)
+}
+
+// ErrJoinedAsConsenter is returned by JoinAsFollower if the orderer joined the channel as a consenter instead of a
+// follower, which happens if the orderer is already in the consenter set of the config block. The orderer remains a
+// member of the channel.
+var ErrJoinedAsConsenter = errors.New("orderer joined channel as consenter instead of follower")
+
+// Admin is a client for the channel participation API of an orderer. It reuses the same HTTP client, and its
+// connections, for all requests.
+type Admin struct {
+	baseURL string
+	client  *http.Client
+}
+
+// AdminOption implements an option for creating a new Admin.
+type AdminOption func(*Admin)
+
+// WithHTTPClient specifies the HTTP client used for requests, instead of one configured using the CA certificate pool
+// and TLS client certificate supplied to NewAdmin.
+func WithHTTPClient(client *http.Client) AdminOption {
+	return func(a *Admin) {
+		a.client = client
+	}
+}
+
+// NewAdmin creates a client for the channel participation API at the specified orderer admin endpoint URL, such as
+// "https://orderer.example.com:7053". Mutual TLS authentication uses the supplied CA certificate pool and TLS client
+// certificate.
+func NewAdmin(osnURL string, caCertPool *x509.CertPool, tlsClientCert tls.Certificate, options ...AdminOption) *Admin {
+	result := &Admin{
+		baseURL: osnURL,
+	}
+
+	for _, option := range options {
+		option(result)
+	}
+
+	if result.client == nil {
+		result.client = &http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{
+					RootCAs:      caCertPool,
+					Certificates: []tls.Certificate{tlsClientCert},
+					MinVersion:   tls.VersionTLS12,
+				},
+			},
+		}
+	}
+
+	return result
+}
+
+// ListChannels returns the channels of which the orderer is a member.
+func (a *Admin) ListChannels(ctx context.Context) (*ChannelList, error) {
+	result := &ChannelList{}
+	if err := a.do(ctx, http.MethodGet, channelsPath, nil, "", result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ChannelInfo returns information about the orderer's membership of a channel. An *APIError with status code
+// http.StatusNotFound is returned if the orderer is not a member of the channel.
+func (a *Admin) ChannelInfo(ctx context.Context, channelID string) (*ChannelInfo, error) {
+	result := &ChannelInfo{}
+	if err := a.do(ctx, http.MethodGet, channelPath(channelID), nil, "", result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Join the orderer to a channel using a config block. The genesis block is used to join the orderer to a new
+// channel, or to an existing channel by replicating the entire ledger from other orderers.
+func (a *Admin) Join(ctx context.Context, configBlock *cb.Block) (*ChannelInfo, error) {
+	blockBytes, err := proto.Marshal(configBlock)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal block: %w", err)
+	}
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile("config-block", "config.block")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := part.Write(blockBytes); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	result := &ChannelInfo{}
+	if err := a.do(ctx, http.MethodPost, channelsPath, body, writer.FormDataContentType(), result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// JoinAsFollower joins the orderer to an existing channel using the most recent config block of the channel, instead
+// of the genesis block. The orderer must not be a consenter in the config block. It joins as a follower, with status
+// StatusOnboarding, and replicates blocks from other orderers until it reaches the config block, after which it
+// becomes active. The orderer can later be added to the consenter set with a config update. If the orderer joins as a
+// consenter instead, an error wrapping ErrJoinedAsConsenter is returned with no channel information.
+func (a *Admin) JoinAsFollower(ctx context.Context, configBlock *cb.Block) (*ChannelInfo, error) {
+	if err := checkConfigBlock(configBlock); err != nil {
+		return nil, err
+	}
+
+	info, err := a.Join(ctx, configBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	if info.ConsensusRelation != ConsensusRelationFollower {
+		if info.ConsensusRelation == ConsensusRelationConsenter {
+			return nil, fmt.Errorf("%w: %s", ErrJoinedAsConsenter, info.Name)
+		}
+		return nil, fmt.Errorf("orderer joined channel %s with consensus relation %s instead of %s", info.Name, info.ConsensusRelation, ConsensusRelationFollower)
+	}
+
+	return info, nil
+}
+
+// Remove the orderer from a channel.
+func (a *Admin) Remove(ctx context.Context, channelID string) error {
+	return a.do(ctx, http.MethodDelete, channelPath(channelID), nil, "", nil)
+}
+
+// WaitForActive polls the orderer, backing off between checks, until the channel status is StatusActive, and returns
+// the channel information at that point. The orderer need not yet be a member of the channel when polling starts. An
+// error is returned if the channel status becomes StatusFailed, or the context is done.
+func (a *Admin) WaitForActive(ctx context.Context, channelID string, options ...wait.Option) (*ChannelInfo, error) {
+	return a.waitFor(ctx, channelID, options, func(info *ChannelInfo) bool {
+		return info.Status == StatusActive
+	})
+}
+
+// WaitForConsenter polls the orderer, backing off between checks, until the channel status is StatusActive and the
+// orderer is a consenter for the channel, and returns the channel information at that point. This indicates that the
+// orderer is ready to order transactions for the channel. An error is returned if the channel status becomes
+// StatusFailed, or the context is done.
+func (a *Admin) WaitForConsenter(ctx context.Context, channelID string, options ...wait.Option) (*ChannelInfo, error) {
+	return a.waitFor(ctx, channelID, options, func(info *ChannelInfo) bool {
+		return info.Status == StatusActive && info.ConsensusRelation == ConsensusRelationConsenter
+	})
+}
+
+func (a *Admin) waitFor(ctx context.Context, channelID string, options []wait.Option, ready func(*ChannelInfo) bool) (*ChannelInfo, error) {
+	var result *ChannelInfo
+
+	err := wait.NewBackoff(options...).Until(ctx, func(ctx context.Context) (bool, error) {
+		info, err := a.ChannelInfo(ctx, channelID)
+		if isNotFound(err) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+
+		result = info
+		if info.Status == StatusFailed {
+			return false, fmt.Errorf("channel %s failed on orderer", channelID)
+		}
+
+		return ready(info), nil
+	})
+
+	return result, err
+}
+
+func (a *Admin) do(ctx context.Context, method string, path string, body io.Reader, contentType string, result any) error {
+	request, err := http.NewRequestWithContext(ctx, method, a.baseURL+path, body)
+	if err != nil {
+		return err
+	}
+	if contentType != "" {
+		request.Header.Set("Content-Type", contentType)
+	}
+
+	response, err := a.client.Do(request) //#nosec G704 -- responsibility of client to supply valid URL root.
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	return readResponse(response, result)
+}
+
+func readResponse(response *http.Response, result any) error {
+	responseBody, err := io.ReadAll(response.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return newAPIError(response.StatusCode, responseBody)
+	}
+
+	if result == nil || len(responseBody) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(responseBody, result); err != nil {
+		return fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return nil
+}
+
+func newAPIError(statusCode int, body []byte) *APIError {
+	result := &APIError{
+		StatusCode: statusCode,
+	}
+
+	var errorResponse struct {
+		Error string `json:"error"`
+	}
+	if err := json.Unmarshal(body, &errorResponse); err == nil && errorResponse.Error != "" {
+		result.Message = errorResponse.Error
+	} else {
+		result.Message = string(bytes.TrimSpace(body))
+	}
+
+	return result
+}
+
+func isNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+func channelPath(channelID string) string {
+	return channelsPath + "/" + url.PathEscape(channelID)
+}
+
+func checkConfigBlock(block *cb.Block) error {
+	if len(block.GetData().GetData()) == 0 {
+		return errors.New("block contains no data")
+	}
+
+	envelope, err := protoutil.UnmarshalEnvelope(block.GetData().GetData()[0])
+	if err != nil {
+		return err
+	}
+
+	payload, err := protoutil.UnmarshalPayload(envelope.GetPayload())
+	if err != nil {
+		return err
+	}
+
+	channelHeader, err := protoutil.UnmarshalChannelHeader(payload.GetHeader().GetChannelHeader())
+	if err != nil {
+		return err
+	}
+
+	if channelHeader.GetType() != int32(cb.HeaderType_CONFIG) {
+		return fmt.Errorf("block is not a config block, header type: %s", cb.HeaderType(channelHeader.GetType()))
+	}
+
+	return nil
+}
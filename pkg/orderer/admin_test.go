@@ -0,0 +1,312 @@
+package orderer_test
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/fabric-admin-sdk/pkg/orderer"
+	"github.com/hyperledger/fabric-admin-sdk/pkg/wait"
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+)
+
+func AssertMarshal(message proto.Message) []byte {
+	result, err := proto.Marshal(message)
+	Expect(err).NotTo(HaveOccurred())
+	return result
+}
+
+func NewBlock(number uint64, headerType cb.HeaderType) *cb.Block {
+	payload := &cb.Payload{
+		Header: &cb.Header{
+			ChannelHeader: AssertMarshal(&cb.ChannelHeader{
+				Type:      int32(headerType),
+				ChannelId: "mychannel",
+			}),
+		},
+	}
+
+	return &cb.Block{
+		Header: &cb.BlockHeader{Number: number},
+		Data: &cb.BlockData{
+			Data: [][]byte{AssertMarshal(&cb.Envelope{Payload: AssertMarshal(payload)})},
+		},
+	}
+}
+
+func WriteJSON(w http.ResponseWriter, status int, value any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	Expect(json.NewEncoder(w).Encode(value)).To(Succeed())
+}
+
+var _ = Describe("Admin", func() {
+	var server *httptest.Server
+	var handler http.HandlerFunc
+	var admin *orderer.Admin
+
+	BeforeEach(func() {
+		server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			handler(w, r)
+		}))
+		DeferCleanup(server.Close)
+
+		admin = orderer.NewAdmin(server.URL, nil, tls.Certificate{}, orderer.WithHTTPClient(server.Client()))
+	})
+
+	It("Lists channels", func(specCtx SpecContext) {
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.Method).To(Equal(http.MethodGet))
+			Expect(r.URL.Path).To(Equal("/participation/v1/channels"))
+			WriteJSON(w, http.StatusOK, map[string]any{
+				"systemChannel": nil,
+				"channels": []map[string]string{
+					{"name": "mychannel", "url": "/participation/v1/channels/mychannel"},
+				},
+			})
+		}
+
+		actual, err := admin.ListChannels(specCtx)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(actual.SystemChannel).To(BeNil())
+		Expect(actual.Channels).To(Equal([]orderer.ChannelInfoShort{
+			{Name: "mychannel", URL: "/participation/v1/channels/mychannel"},
+		}))
+	})
+
+	It("Gets channel information", func(specCtx SpecContext) {
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.Method).To(Equal(http.MethodGet))
+			Expect(r.URL.Path).To(Equal("/participation/v1/channels/mychannel"))
+			WriteJSON(w, http.StatusOK, map[string]any{
+				"name":              "mychannel",
+				"url":               "/participation/v1/channels/mychannel",
+				"consensusRelation": "consenter",
+				"status":            "active",
+				"height":            5,
+			})
+		}
+
+		actual, err := admin.ChannelInfo(specCtx, "mychannel")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(actual).To(Equal(&orderer.ChannelInfo{
+			Name:              "mychannel",
+			URL:               "/participation/v1/channels/mychannel",
+			ConsensusRelation: orderer.ConsensusRelationConsenter,
+			Status:            orderer.StatusActive,
+			Height:            5,
+		}))
+	})
+
+	It("Returns API error from JSON error body", func(specCtx SpecContext) {
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			WriteJSON(w, http.StatusNotFound, map[string]string{"error": "channel does not exist"})
+		}
+
+		_, err := admin.ChannelInfo(specCtx, "mychannel")
+
+		var apiErr *orderer.APIError
+		Expect(errors.As(err, &apiErr)).To(BeTrue(), "Expected APIError, got %v", err)
+		Expect(apiErr.StatusCode).To(Equal(http.StatusNotFound))
+		Expect(apiErr.Message).To(Equal("channel does not exist"))
+	})
+
+	It("Returns API error with plain text body", func(specCtx SpecContext) {
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "unexpected failure", http.StatusInternalServerError)
+		}
+
+		_, err := admin.ListChannels(specCtx)
+
+		var apiErr *orderer.APIError
+		Expect(errors.As(err, &apiErr)).To(BeTrue(), "Expected APIError, got %v", err)
+		Expect(apiErr.StatusCode).To(Equal(http.StatusInternalServerError))
+		Expect(apiErr.Message).To(Equal("unexpected failure"))
+	})
+
+	It("Joins channel with config block", func(specCtx SpecContext) {
+		block := NewBlock(0, cb.HeaderType_CONFIG)
+
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.Method).To(Equal(http.MethodPost))
+			Expect(r.URL.Path).To(Equal("/participation/v1/channels"))
+
+			file, header, err := r.FormFile("config-block")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(header.Filename).To(Equal("config.block"))
+			blockBytes, err := io.ReadAll(file)
+			Expect(err).NotTo(HaveOccurred())
+
+			actual := &cb.Block{}
+			Expect(proto.Unmarshal(blockBytes, actual)).To(Succeed())
+			Expect(proto.Equal(actual, block)).To(BeTrue())
+
+			WriteJSON(w, http.StatusCreated, map[string]any{
+				"name":              "mychannel",
+				"consensusRelation": "consenter",
+				"status":            "active",
+				"height":            1,
+			})
+		}
+
+		actual, err := admin.Join(specCtx, block)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(actual.Name).To(Equal("mychannel"))
+		Expect(actual.Status).To(Equal(orderer.StatusActive))
+	})
+
+	It("Joins channel as follower with config block", func(specCtx SpecContext) {
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			WriteJSON(w, http.StatusCreated, map[string]any{
+				"name":              "mychannel",
+				"consensusRelation": "follower",
+				"status":            "onboarding",
+				"height":            0,
+			})
+		}
+
+		actual, err := admin.JoinAsFollower(specCtx, NewBlock(7, cb.HeaderType_CONFIG))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(actual.ConsensusRelation).To(Equal(orderer.ConsensusRelationFollower))
+		Expect(actual.Status).To(Equal(orderer.StatusOnboarding))
+	})
+
+	It("Join as follower fails for non-config block", func(specCtx SpecContext) {
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			Fail("Unexpected request")
+		}
+
+		_, err := admin.JoinAsFollower(specCtx, NewBlock(7, cb.HeaderType_ENDORSER_TRANSACTION))
+
+		Expect(err).To(MatchError(ContainSubstring("not a config block")))
+	})
+
+	It("Join as follower fails if orderer joins as consenter", func(specCtx SpecContext) {
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			WriteJSON(w, http.StatusCreated, map[string]any{
+				"name":              "mychannel",
+				"consensusRelation": "consenter",
+				"status":            "onboarding",
+			})
+		}
+
+		actual, err := admin.JoinAsFollower(specCtx, NewBlock(7, cb.HeaderType_CONFIG))
+
+		Expect(err).To(MatchError(orderer.ErrJoinedAsConsenter))
+		Expect(err).To(MatchError(ContainSubstring("mychannel")))
+		Expect(actual).To(BeNil())
+	})
+
+	It("Removes channel", func(specCtx SpecContext) {
+		var method, path string
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			method = r.Method
+			path = r.URL.Path
+			w.WriteHeader(http.StatusNoContent)
+		}
+
+		Expect(admin.Remove(specCtx, "mychannel")).To(Succeed())
+
+		Expect(method).To(Equal(http.MethodDelete))
+		Expect(path).To(Equal("/participation/v1/channels/mychannel"))
+	})
+
+	It("Waits for channel to become active", func(specCtx SpecContext) {
+		var lock sync.Mutex
+		statuses := []string{orderer.StatusOnboarding, orderer.StatusOnboarding, orderer.StatusActive}
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			lock.Lock()
+			status := statuses[0]
+			statuses = statuses[1:]
+			lock.Unlock()
+
+			WriteJSON(w, http.StatusOK, map[string]any{"name": "mychannel", "status": status})
+		}
+
+		actual, err := admin.WaitForActive(specCtx, "mychannel", wait.WithBackoff(time.Millisecond, 5*time.Millisecond))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(actual.Status).To(Equal(orderer.StatusActive))
+		Expect(statuses).To(BeEmpty())
+	})
+
+	It("Wait fails if channel fails", func(specCtx SpecContext) {
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			WriteJSON(w, http.StatusOK, map[string]any{"name": "mychannel", "status": orderer.StatusFailed})
+		}
+
+		_, err := admin.WaitForActive(specCtx, "mychannel", wait.WithBackoff(time.Millisecond, 5*time.Millisecond))
+
+		Expect(err).To(MatchError(ContainSubstring("failed")))
+	})
+
+	It("Wait ends when context is done", func(specCtx SpecContext) {
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			WriteJSON(w, http.StatusOK, map[string]any{"name": "mychannel", "status": orderer.StatusOnboarding})
+		}
+
+		ctx, cancel := context.WithTimeout(specCtx, 50*time.Millisecond)
+		defer cancel()
+
+		_, err := admin.WaitForActive(ctx, "mychannel", wait.WithBackoff(time.Millisecond, 5*time.Millisecond))
+
+		Expect(err).To(MatchError(context.DeadlineExceeded))
+	})
+	It("Waits for orderer to become consenter for channel", func(specCtx SpecContext) {
+		var lock sync.Mutex
+		responses := []func(http.ResponseWriter){
+			func(w http.ResponseWriter) {
+				WriteJSON(w, http.StatusNotFound, map[string]string{"error": "channel does not exist"})
+			},
+			func(w http.ResponseWriter) {
+				WriteJSON(w, http.StatusOK, map[string]any{"name": "mychannel", "status": "onboarding", "consensusRelation": "follower"})
+			},
+			func(w http.ResponseWriter) {
+				WriteJSON(w, http.StatusOK, map[string]any{"name": "mychannel", "status": "active", "consensusRelation": "follower"})
+			},
+			func(w http.ResponseWriter) {
+				WriteJSON(w, http.StatusOK, map[string]any{"name": "mychannel", "status": "active", "consensusRelation": "consenter"})
+			},
+		}
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			lock.Lock()
+			respond := responses[0]
+			responses = responses[1:]
+			lock.Unlock()
+
+			respond(w)
+		}
+
+		actual, err := admin.WaitForConsenter(specCtx, "mychannel", wait.WithBackoff(time.Millisecond, 5*time.Millisecond))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(actual.Status).To(Equal(orderer.StatusActive))
+		Expect(actual.ConsensusRelation).To(Equal(orderer.ConsensusRelationConsenter))
+		Expect(responses).To(BeEmpty())
+	})
+
+	It("Wait returns errors other than not found", func(specCtx SpecContext) {
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			WriteJSON(w, http.StatusForbidden, map[string]string{"error": "access denied"})
+		}
+
+		_, err := admin.WaitForConsenter(specCtx, "mychannel", wait.WithBackoff(time.Millisecond, 5*time.Millisecond))
+
+		var apiErr *orderer.APIError
+		Expect(errors.As(err, &apiErr)).To(BeTrue(), "Expected APIError, got %v", err)
+		Expect(apiErr.StatusCode).To(Equal(http.StatusForbidden))
+	})
+})
@@ -0,0 +1,13 @@
+package orderer_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestOrderer(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Orderer Suite")
+}
@@ -0,0 +1,378 @@
+// Package protolator converts Fabric protobuf messages to and from JSON, in the same deep-decoded form produced and
+// consumed by the Fabric configtxlator proto_decode and proto_encode commands. Fields containing serialized protobuf
+// messages, such as the payload of an envelope, the values and policies of channel configuration, MSP configuration
+// and consensus metadata, are expanded into nested JSON objects instead of being represented as base64 strings.
+package protolator
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var (
+	marshalOptions = protojson.MarshalOptions{
+		UseProtoNames:   true,
+		EmitUnpopulated: true,
+	}
+	deterministic = proto.MarshalOptions{
+		Deterministic: true,
+	}
+)
+
+// DeepMarshalJSON writes a protobuf message, such as a *common.Config, *common.ConfigUpdateEnvelope or
+// *common.Block, to the writer as JSON. Serialized messages nested within the message are also decoded. Nested
+// content whose type cannot be determined, such as config values with unrecognized keys, is written as base64.
+func DeepMarshalJSON(w io.Writer, msg proto.Message) error {
+	tree, err := messageToTree(msg.ProtoReflect(), scope{})
+	if err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "\t")
+	if err := encoder.Encode(tree); err != nil {
+		return fmt.Errorf("failed to write JSON: %w", err)
+	}
+
+	return nil
+}
+
+// DeepUnmarshalJSON reads JSON in the form written by DeepMarshalJSON from the reader, and populates the supplied
+// protobuf message. Nested JSON objects representing serialized messages are re-serialized deterministically, so the
+// original message bytes are reproduced exactly provided they were also serialized deterministically.
+func DeepUnmarshalJSON(r io.Reader, msg proto.Message) error {
+	decoder := json.NewDecoder(r)
+	decoder.UseNumber()
+
+	var tree map[string]any
+	if err := decoder.Decode(&tree); err != nil {
+		return fmt.Errorf("failed to read JSON: %w", err)
+	}
+
+	proto.Reset(msg)
+	return treeToMessage(tree, msg.ProtoReflect(), scope{})
+}
+
+func messageToTree(message protoreflect.Message, s scope) (map[string]any, error) {
+	jsonBytes, err := marshalOptions.Marshal(message.Interface())
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s: %w", message.Descriptor().FullName(), err)
+	}
+
+	tree, err := decodeTree(jsonBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := message.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		if field.ContainingOneof() != nil && !message.Has(field) {
+			continue
+		}
+
+		value, err := fieldToTree(message, field, s)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %w", message.Descriptor().Name(), field.Name(), err)
+		}
+		if value != nil {
+			tree[string(field.Name())] = value
+		}
+	}
+
+	return tree, nil
+}
+
+// fieldToTree returns the deep-decoded JSON representation of a field, or nil if the representation produced by
+// protojson does not need to be replaced.
+func fieldToTree(message protoreflect.Message, field protoreflect.FieldDescriptor, s scope) (any, error) {
+	switch {
+	case field.IsMap():
+		if !isNestedMessage(field.MapValue()) {
+			return nil, nil
+		}
+		return mapToTree(message, field, s)
+
+	case field.IsList():
+		return listToTree(message, field, s)
+
+	case isNestedMessage(field):
+		if !message.Has(field) {
+			return nil, nil
+		}
+		return messageToTree(message.Get(field).Message(), s.child(message, field, ""))
+
+	case field.Kind() == protoreflect.BytesKind:
+		result, err := opaqueToTree(message, field, message.Get(field).Bytes(), s)
+		if result == nil || err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	default:
+		return nil, nil
+	}
+}
+
+func mapToTree(message protoreflect.Message, field protoreflect.FieldDescriptor, s scope) (map[string]any, error) {
+	result := make(map[string]any)
+
+	var err error
+	message.Get(field).Map().Range(func(key protoreflect.MapKey, value protoreflect.Value) bool {
+		result[key.String()], err = messageToTree(value.Message(), s.child(message, field, key.String()))
+		return err == nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func listToTree(message protoreflect.Message, field protoreflect.FieldDescriptor, s scope) (any, error) {
+	if !isNestedMessage(field) && field.Kind() != protoreflect.BytesKind {
+		return nil, nil
+	}
+
+	list := message.Get(field).List()
+	result := make([]any, list.Len())
+
+	for i := range result {
+		var element map[string]any
+		var err error
+		if isNestedMessage(field) {
+			element, err = messageToTree(list.Get(i).Message(), s.child(message, field, ""))
+		} else {
+			element, err = opaqueToTree(message, field, list.Get(i).Bytes(), s)
+		}
+
+		if element == nil || err != nil {
+			return nil, err
+		}
+		result[i] = element
+	}
+
+	return result, nil
+}
+
+// opaqueToTree decodes a serialized message contained in a bytes field. It returns nil if the type of the serialized
+// message cannot be determined.
+func opaqueToTree(message protoreflect.Message, field protoreflect.FieldDescriptor, value []byte, s scope) (map[string]any, error) {
+	opaque := opaqueMessage(message.Interface(), field.Name(), s)
+	if opaque == nil {
+		return nil, nil
+	}
+
+	if err := proto.Unmarshal(value, opaque); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s: %w", opaque.ProtoReflect().Descriptor().FullName(), err)
+	}
+
+	return messageToTree(opaque.ProtoReflect(), s.child(message, field, ""))
+}
+
+func treeToMessage(tree map[string]any, message protoreflect.Message, s scope) error {
+	plain, nestedFields, opaqueFields := splitFields(tree, message.Descriptor())
+
+	plainBytes, err := json.Marshal(plain)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s: %w", message.Descriptor().FullName(), err)
+	}
+
+	if err := protojson.Unmarshal(plainBytes, message.Interface()); err != nil {
+		return fmt.Errorf("failed to unmarshal %s: %w", message.Descriptor().FullName(), err)
+	}
+
+	// Nested messages are populated before serialized messages, since the type of a serialized message may depend on
+	// the content of a nested message, such as the header of a payload.
+	for _, field := range nestedFields {
+		if err := treeToNestedField(tree[string(field.Name())], message, field, s); err != nil {
+			return fmt.Errorf("%s.%s: %w", message.Descriptor().Name(), field.Name(), err)
+		}
+	}
+
+	for _, field := range opaqueFields {
+		if err := treeToOpaqueField(tree[string(field.Name())], message, field, s); err != nil {
+			return fmt.Errorf("%s.%s: %w", message.Descriptor().Name(), field.Name(), err)
+		}
+	}
+
+	return nil
+}
+
+// splitFields separates the fields of a JSON tree into plain fields that can be unmarshaled directly by protojson,
+// nested message fields, and bytes fields represented as JSON objects that contain serialized messages.
+func splitFields(tree map[string]any, descriptor protoreflect.MessageDescriptor) (map[string]any, []protoreflect.FieldDescriptor, []protoreflect.FieldDescriptor) {
+	plain := make(map[string]any, len(tree))
+	for name, value := range tree {
+		plain[name] = value
+	}
+
+	var nestedFields, opaqueFields []protoreflect.FieldDescriptor
+	fields := descriptor.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		value := tree[string(field.Name())]
+
+		switch {
+		case value == nil:
+			continue
+		case isNestedMessage(field) || (field.IsMap() && isNestedMessage(field.MapValue())):
+			nestedFields = append(nestedFields, field)
+		case field.Kind() == protoreflect.BytesKind && containsObject(value):
+			opaqueFields = append(opaqueFields, field)
+		default:
+			continue
+		}
+
+		delete(plain, string(field.Name()))
+	}
+
+	return plain, nestedFields, opaqueFields
+}
+
+func treeToNestedField(value any, message protoreflect.Message, field protoreflect.FieldDescriptor, s scope) error {
+	switch {
+	case field.IsMap():
+		entries, ok := value.(map[string]any)
+		if !ok {
+			return fmt.Errorf("expected JSON object, got %T", value)
+		}
+
+		result := message.Mutable(field).Map()
+		for key, entry := range entries {
+			element := result.NewValue()
+			if err := treeToNestedMessage(entry, element.Message(), s.child(message, field, key)); err != nil {
+				return fmt.Errorf("key %q: %w", key, err)
+			}
+			result.Set(protoreflect.ValueOfString(key).MapKey(), element)
+		}
+
+	case field.IsList():
+		elements, ok := value.([]any)
+		if !ok {
+			return fmt.Errorf("expected JSON array, got %T", value)
+		}
+
+		result := message.Mutable(field).List()
+		for i, entry := range elements {
+			element := result.NewElement()
+			if err := treeToNestedMessage(entry, element.Message(), s.child(message, field, "")); err != nil {
+				return fmt.Errorf("index %d: %w", i, err)
+			}
+			result.Append(element)
+		}
+
+	default:
+		return treeToNestedMessage(value, message.Mutable(field).Message(), s.child(message, field, ""))
+	}
+
+	return nil
+}
+
+func treeToNestedMessage(value any, message protoreflect.Message, s scope) error {
+	tree, ok := value.(map[string]any)
+	if !ok {
+		return fmt.Errorf("expected JSON object, got %T", value)
+	}
+
+	return treeToMessage(tree, message, s)
+}
+
+func treeToOpaqueField(value any, message protoreflect.Message, field protoreflect.FieldDescriptor, s scope) error {
+	if !field.IsList() {
+		opaque, err := treeToOpaque(value, message, field, s)
+		if err != nil {
+			return err
+		}
+
+		message.Set(field, protoreflect.ValueOfBytes(opaque))
+		return nil
+	}
+
+	elements, ok := value.([]any)
+	if !ok {
+		return fmt.Errorf("expected JSON array, got %T", value)
+	}
+
+	result := message.Mutable(field).List()
+	for i, element := range elements {
+		opaque, err := treeToOpaque(element, message, field, s)
+		if err != nil {
+			return fmt.Errorf("index %d: %w", i, err)
+		}
+		result.Append(protoreflect.ValueOfBytes(opaque))
+	}
+
+	return nil
+}
+
+func treeToOpaque(value any, message protoreflect.Message, field protoreflect.FieldDescriptor, s scope) ([]byte, error) {
+	if encoded, ok := value.(string); ok {
+		var result []byte
+		if err := json.Unmarshal([]byte(`"`+encoded+`"`), &result); err != nil {
+			return nil, fmt.Errorf("invalid base64 content: %w", err)
+		}
+		return result, nil
+	}
+
+	opaque := opaqueMessage(message.Interface(), field.Name(), s)
+	if opaque == nil {
+		return nil, errors.New("cannot determine message type")
+	}
+
+	if err := treeToNestedMessage(value, opaque.ProtoReflect(), s.child(message, field, "")); err != nil {
+		return nil, err
+	}
+
+	result, err := deterministic.Marshal(opaque)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s: %w", opaque.ProtoReflect().Descriptor().FullName(), err)
+	}
+
+	return result, nil
+}
+
+func decodeTree(jsonBytes []byte) (map[string]any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(jsonBytes))
+	decoder.UseNumber()
+
+	var result map[string]any
+	if err := decoder.Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode JSON: %w", err)
+	}
+
+	return result, nil
+}
+
+// isNestedMessage reports whether a field is a message that may itself contain serialized messages. Well-known
+// types, such as timestamps, have special JSON representations and are left to protojson.
+func isNestedMessage(field protoreflect.FieldDescriptor) bool {
+	if field.Kind() != protoreflect.MessageKind {
+		return false
+	}
+
+	return !strings.HasPrefix(string(field.Message().FullName()), "google.protobuf.")
+}
+
+func containsObject(value any) bool {
+	switch v := value.(type) {
+	case map[string]any:
+		return true
+	case []any:
+		for _, element := range v {
+			if containsObject(element) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
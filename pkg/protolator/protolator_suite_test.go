@@ -0,0 +1,13 @@
+package protolator_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestProtolator(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Protolator Suite")
+}
@@ -0,0 +1,227 @@
+package protolator_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/hyperledger/fabric-admin-sdk/pkg/channel"
+	"github.com/hyperledger/fabric-admin-sdk/pkg/protolator"
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"github.com/hyperledger/fabric-protos-go-apiv2/msp"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+)
+
+const testDataDir = "../../test/data"
+
+func NewTestGenesisBlock() *cb.Block {
+	ordererTLSCert, err := os.ReadFile(filepath.Join(testDataDir, "ordererOrganizations", "example.com", "orderers", "orderer.example.com", "tls", "server.crt"))
+	Expect(err).NotTo(HaveOccurred())
+
+	block, err := channel.NewGenesisBlock("mychannel", &channel.GenesisConfig{
+		Capabilities: []string{"V3_0"},
+		Orderer: &channel.OrdererConfig{
+			OrdererType: channel.ConsensusTypeEtcdRaft,
+			Consenters: []*channel.Consenter{
+				{
+					Host:          "orderer.example.com",
+					Port:          7050,
+					ClientTLSCert: ordererTLSCert,
+					ServerTLSCert: ordererTLSCert,
+				},
+			},
+			Organizations: []*channel.Organization{
+				{
+					Name:             "OrdererOrg",
+					MSPID:            "OrdererMSP",
+					MSPDir:           filepath.Join(testDataDir, "ordererOrganizations", "example.com", "msp"),
+					OrdererEndpoints: []string{"orderer.example.com:7050"},
+				},
+			},
+		},
+		Application: &channel.ApplicationConfig{
+			Organizations: []*channel.Organization{
+				{
+					MSPID:  "Org1MSP",
+					MSPDir: filepath.Join(testDataDir, "peerOrganizations", "org1.example.com", "msp"),
+					AnchorPeers: []*channel.AnchorPeer{
+						{Host: "peer0.org1.example.com", Port: 7051},
+					},
+				},
+			},
+		},
+	})
+	Expect(err).NotTo(HaveOccurred())
+
+	return block
+}
+
+func AssertMarshal(message proto.Message) []byte {
+	result, err := proto.MarshalOptions{Deterministic: true}.Marshal(message)
+	Expect(err).NotTo(HaveOccurred())
+	return result
+}
+
+func NewTestSignedBlock() *cb.Block {
+	block := &cb.Block{
+		Header: &cb.BlockHeader{Number: 1, PreviousHash: []byte("previous"), DataHash: []byte("data")},
+		Data: &cb.BlockData{
+			Data: [][]byte{AssertMarshal(&cb.Envelope{
+				Payload: AssertMarshal(&cb.Payload{
+					Header: &cb.Header{
+						ChannelHeader: AssertMarshal(&cb.ChannelHeader{Type: int32(cb.HeaderType_ENDORSER_TRANSACTION), ChannelId: "mychannel"}),
+					},
+				}),
+			})},
+		},
+		Metadata: &cb.BlockMetadata{
+			Metadata: make([][]byte, len(cb.BlockMetadataIndex_name)),
+		},
+	}
+	block.Metadata.Metadata[cb.BlockMetadataIndex_SIGNATURES] = AssertMarshal(&cb.Metadata{
+		Value: []byte("value"),
+		Signatures: []*cb.MetadataSignature{
+			{
+				SignatureHeader: AssertMarshal(&cb.SignatureHeader{
+					Creator: AssertMarshal(&msp.SerializedIdentity{Mspid: "OrdererMSP", IdBytes: []byte("certificate")}),
+					Nonce:   []byte("nonce"),
+				}),
+				Signature: []byte("signature"),
+			},
+		},
+	})
+
+	return block
+}
+
+func DeepMarshalTree(msg proto.Message) map[string]any {
+	var buffer bytes.Buffer
+	Expect(protolator.DeepMarshalJSON(&buffer, msg)).To(Succeed())
+
+	var result map[string]any
+	Expect(json.Unmarshal(buffer.Bytes(), &result)).To(Succeed())
+	return result
+}
+
+func Path(tree any, keys ...any) any {
+	for _, key := range keys {
+		switch k := key.(type) {
+		case string:
+			Expect(tree).To(HaveKey(k))
+			tree = tree.(map[string]any)[k]
+		case int:
+			Expect(len(tree.([]any))).To(BeNumerically(">", k))
+			tree = tree.([]any)[k]
+		}
+	}
+
+	return tree
+}
+
+var _ = Describe("Protolator", func() {
+	It("Decodes nested config values and policies", func() {
+		block := NewTestGenesisBlock()
+		config, err := channel.ConfigFromBlock(block)
+		Expect(err).NotTo(HaveOccurred())
+
+		tree := DeepMarshalTree(config)
+		application := Path(tree, "channel_group", "groups", "Application", "groups", "Org1MSP")
+
+		Expect(Path(application, "values", "MSP", "value", "config", "name")).To(Equal("Org1MSP"))
+		Expect(Path(application, "values", "AnchorPeers", "value", "anchor_peers", 0, "host")).To(Equal("peer0.org1.example.com"))
+		Expect(Path(application, "policies", "Admins", "policy", "value", "identities", 0, "principal", "msp_identifier")).To(Equal("Org1MSP"))
+		Expect(Path(tree, "channel_group", "groups", "Application", "policies", "Admins", "policy", "value")).To(Equal(map[string]any{
+			"rule":       "MAJORITY",
+			"sub_policy": "Admins",
+		}))
+
+		consensusType := Path(tree, "channel_group", "groups", "Orderer", "values", "ConsensusType", "value")
+		Expect(Path(consensusType, "metadata", "consenters", 0, "host")).To(Equal("orderer.example.com"))
+	})
+
+	It("Decodes block envelopes", func() {
+		tree := DeepMarshalTree(NewTestGenesisBlock())
+
+		payload := Path(tree, "data", "data", 0, "payload")
+		Expect(Path(payload, "header", "channel_header", "channel_id")).To(Equal("mychannel"))
+		Expect(Path(payload, "data", "config", "channel_group", "groups", "Orderer", "values", "BatchSize", "value")).To(HaveKey("max_message_count"))
+	})
+
+	It("Decodes block metadata signatures", func() {
+		tree := DeepMarshalTree(NewTestSignedBlock())
+
+		signatures := Path(tree, "metadata", "metadata", int(cb.BlockMetadataIndex_SIGNATURES))
+		Expect(Path(signatures, "value")).To(Equal("dmFsdWU="))
+		Expect(Path(signatures, "signatures", 0, "signature_header", "creator", "mspid")).To(Equal("OrdererMSP"))
+		Expect(Path(signatures, "signatures", 0, "signature_header", "nonce")).To(Equal("bm9uY2U="))
+		Expect(Path(signatures, "signatures", 0, "signature")).To(Equal("c2lnbmF0dXJl"))
+	})
+
+	It("Leaves values with unknown keys as base64", func() {
+		config := &cb.Config{
+			ChannelGroup: &cb.ConfigGroup{
+				Values: map[string]*cb.ConfigValue{
+					"Unknown": {Value: []byte("value")},
+				},
+			},
+		}
+
+		tree := DeepMarshalTree(config)
+		Expect(Path(tree, "channel_group", "values", "Unknown", "value")).To(Equal("dmFsdWU="))
+	})
+
+	It("Round trips config update envelope byte for byte", func() {
+		expected, err := os.ReadFile(filepath.Join("..", "..", "test", "org3_update_in_envelope.pb"))
+		Expect(err).NotTo(HaveOccurred())
+
+		envelope := &cb.Envelope{}
+		Expect(proto.Unmarshal(expected, envelope)).To(Succeed())
+
+		var buffer bytes.Buffer
+		Expect(protolator.DeepMarshalJSON(&buffer, envelope)).To(Succeed())
+		Expect(buffer.String()).To(ContainSubstring(`"name": "Org3MSP"`))
+
+		actual := &cb.Envelope{}
+		Expect(protolator.DeepUnmarshalJSON(&buffer, actual)).To(Succeed())
+
+		actualBytes, err := proto.MarshalOptions{Deterministic: true}.Marshal(actual)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(actualBytes).To(Equal(expected))
+	})
+
+	It("Round trips genesis block", func() {
+		block := NewTestGenesisBlock()
+
+		var expected bytes.Buffer
+		Expect(protolator.DeepMarshalJSON(&expected, block)).To(Succeed())
+
+		actual := &cb.Block{}
+		Expect(protolator.DeepUnmarshalJSON(bytes.NewReader(expected.Bytes()), actual)).To(Succeed())
+
+		var actualJSON bytes.Buffer
+		Expect(protolator.DeepMarshalJSON(&actualJSON, actual)).To(Succeed())
+		Expect(actualJSON.String()).To(Equal(expected.String()))
+	})
+
+	It("Round trips signed block byte for byte", func() {
+		expected := AssertMarshal(NewTestSignedBlock())
+
+		block := &cb.Block{}
+		Expect(proto.Unmarshal(expected, block)).To(Succeed())
+
+		var buffer bytes.Buffer
+		Expect(protolator.DeepMarshalJSON(&buffer, block)).To(Succeed())
+
+		actual := &cb.Block{}
+		Expect(protolator.DeepUnmarshalJSON(&buffer, actual)).To(Succeed())
+		Expect(AssertMarshal(actual)).To(Equal(expected))
+	})
+
+	It("Fails to decode invalid JSON", func() {
+		err := protolator.DeepUnmarshalJSON(bytes.NewReader([]byte(`{"payload": {"header": "invalid"}}`)), &cb.Envelope{})
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,274 @@
+package protolator
+
+import (
+	"github.com/hyperledger/fabric-admin-sdk/internal/channelconfig"
+	imsp "github.com/hyperledger/fabric-admin-sdk/internal/msp"
+
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"github.com/hyperledger/fabric-protos-go-apiv2/ledger/rwset"
+	"github.com/hyperledger/fabric-protos-go-apiv2/ledger/rwset/kvrwset"
+	"github.com/hyperledger/fabric-protos-go-apiv2/msp"
+	ab "github.com/hyperledger/fabric-protos-go-apiv2/orderer"
+	"github.com/hyperledger/fabric-protos-go-apiv2/orderer/etcdraft"
+	"github.com/hyperledger/fabric-protos-go-apiv2/orderer/smartbft"
+	pb "github.com/hyperledger/fabric-protos-go-apiv2/peer"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Types of config group, which determine the types of the values and child groups they contain.
+const (
+	channelGroup        = "Channel"
+	ordererGroup        = "Orderer"
+	ordererOrgGroup     = "OrdererOrg"
+	applicationGroup    = "Application"
+	applicationOrgGroup = "ApplicationOrg"
+	consortiumsGroup    = "Consortiums"
+	consortiumGroup     = "Consortium"
+	consortiumOrgGroup  = "ConsortiumOrg"
+	consortiumsGroupKey = "Consortiums"
+)
+
+// Consensus types with metadata.
+const (
+	consensusTypeEtcdRaft = "etcdraft"
+	consensusTypeBFT      = "BFT"
+)
+
+// scope identifies the location of a message within channel configuration, which determines the types of serialized
+// config values it contains.
+type scope struct {
+	// groupType is the type of the config group containing the message.
+	groupType string
+
+	// key is the map key of a config value.
+	key string
+}
+
+// child returns the scope of a message contained in the specified field of a parent message.
+func (s scope) child(parent protoreflect.Message, field protoreflect.FieldDescriptor, key string) scope {
+	switch parent.Interface().(type) {
+	case *cb.Config, *cb.ConfigUpdate:
+		return scope{groupType: channelGroup}
+
+	case *cb.ConfigGroup:
+		switch field.Name() {
+		case "groups":
+			return scope{groupType: childGroupType(s.groupType, key)}
+		case "values":
+			return scope{groupType: s.groupType, key: key}
+		default:
+			return scope{groupType: s.groupType}
+		}
+
+	default:
+		return s
+	}
+}
+
+func childGroupType(groupType string, name string) string {
+	switch groupType {
+	case channelGroup:
+		switch name {
+		case channelconfig.OrdererGroupKey:
+			return ordererGroup
+		case channelconfig.ApplicationGroupKey:
+			return applicationGroup
+		case consortiumsGroupKey:
+			return consortiumsGroup
+		}
+	case ordererGroup:
+		return ordererOrgGroup
+	case applicationGroup:
+		return applicationOrgGroup
+	case consortiumsGroup:
+		return consortiumGroup
+	case consortiumGroup:
+		return consortiumOrgGroup
+	}
+
+	return ""
+}
+
+// opaqueField returns an empty message of the type serialized in a bytes field, based on the message containing the
+// field and its scope, or nil if the type cannot be determined.
+type opaqueField func(message proto.Message, s scope) proto.Message
+
+// opaqueFields describes the bytes fields containing serialized messages, keyed by containing message type and field
+// name.
+var opaqueFields = map[protoreflect.FullName]map[protoreflect.Name]opaqueField{
+	fullName(&cb.Envelope{}):             {"payload": static(&cb.Payload{})},
+	fullName(&cb.Payload{}):              {"data": payloadDataMessage},
+	fullName(&cb.Header{}):               {"channel_header": static(&cb.ChannelHeader{}), "signature_header": static(&cb.SignatureHeader{})},
+	fullName(&cb.SignatureHeader{}):      {"creator": static(&msp.SerializedIdentity{})},
+	fullName(&cb.BlockData{}):            {"data": static(&cb.Envelope{})},
+	fullName(&cb.BlockMetadata{}):        {"metadata": static(&cb.Metadata{})},
+	fullName(&cb.MetadataSignature{}):    {"signature_header": static(&cb.SignatureHeader{})},
+	fullName(&cb.ConfigUpdateEnvelope{}): {"config_update": static(&cb.ConfigUpdate{})},
+	fullName(&cb.ConfigSignature{}):      {"signature_header": static(&cb.SignatureHeader{})},
+	fullName(&cb.ConfigValue{}):          {"value": configValueMessage},
+	fullName(&cb.Policy{}):               {"value": policyMessage},
+	fullName(&msp.MSPPrincipal{}):        {"principal": principalMessage},
+	fullName(&msp.MSPConfig{}):           {"config": mspConfigMessage},
+	fullName(&ab.ConsensusType{}):        {"metadata": consensusMetadataMessage},
+
+	// Endorser transactions
+	fullName(&pb.TransactionAction{}):               {"header": static(&cb.SignatureHeader{}), "payload": static(&pb.ChaincodeActionPayload{})},
+	fullName(&pb.ChaincodeActionPayload{}):          {"chaincode_proposal_payload": static(&pb.ChaincodeProposalPayload{})},
+	fullName(&pb.ChaincodeProposalPayload{}):        {"input": static(&pb.ChaincodeInvocationSpec{})},
+	fullName(&pb.ChaincodeEndorsedAction{}):         {"proposal_response_payload": static(&pb.ProposalResponsePayload{})},
+	fullName(&pb.Endorsement{}):                     {"endorser": static(&msp.SerializedIdentity{})},
+	fullName(&pb.ProposalResponsePayload{}):         {"extension": static(&pb.ChaincodeAction{})},
+	fullName(&pb.ChaincodeAction{}):                 {"results": static(&rwset.TxReadWriteSet{}), "events": static(&pb.ChaincodeEvent{})},
+	fullName(&rwset.NsReadWriteSet{}):               {"rwset": static(&kvrwset.KVRWSet{})},
+	fullName(&rwset.CollectionHashedReadWriteSet{}): {"hashed_rwset": static(&kvrwset.HashedRWSet{})},
+}
+
+// opaqueMessage returns an empty message of the type serialized in the named bytes field of a message, or nil if the
+// field does not contain a serialized message of known type.
+func opaqueMessage(message proto.Message, field protoreflect.Name, s scope) proto.Message {
+	newMessage, ok := opaqueFields[fullName(message)][field]
+	if !ok {
+		return nil
+	}
+
+	return newMessage(message, s)
+}
+
+func fullName(message proto.Message) protoreflect.FullName {
+	return message.ProtoReflect().Descriptor().FullName()
+}
+
+// static describes a bytes field that always contains a serialized message of the same type as the prototype.
+func static(prototype proto.Message) opaqueField {
+	return func(proto.Message, scope) proto.Message {
+		return newMessage(prototype)
+	}
+}
+
+func newMessage(prototype proto.Message) proto.Message {
+	return prototype.ProtoReflect().Type().New().Interface()
+}
+
+func payloadDataMessage(message proto.Message, _ scope) proto.Message {
+	payload, _ := message.(*cb.Payload)
+	channelHeader := &cb.ChannelHeader{}
+	if err := proto.Unmarshal(payload.GetHeader().GetChannelHeader(), channelHeader); err != nil {
+		return nil
+	}
+
+	switch cb.HeaderType(channelHeader.GetType()) {
+	case cb.HeaderType_CONFIG:
+		return &cb.ConfigEnvelope{}
+	case cb.HeaderType_CONFIG_UPDATE:
+		return &cb.ConfigUpdateEnvelope{}
+	case cb.HeaderType_ENDORSER_TRANSACTION:
+		return &pb.Transaction{}
+	case cb.HeaderType_ORDERER_TRANSACTION:
+		return &cb.Envelope{}
+	default:
+		return nil
+	}
+}
+
+// configValues describes the type of each config value, keyed by config group type and value key.
+var configValues = map[string]map[string]proto.Message{
+	channelGroup: {
+		channelconfig.HashingAlgorithmKey:          &cb.HashingAlgorithm{},
+		channelconfig.BlockDataHashingStructureKey: &cb.BlockDataHashingStructure{},
+		channelconfig.OrdererAddressesKey:          &cb.OrdererAddresses{},
+		channelconfig.ConsortiumKey:                &cb.Consortium{},
+		channelconfig.CapabilitiesKey:              &cb.Capabilities{},
+	},
+	ordererGroup: {
+		channelconfig.ConsensusTypeKey:       &ab.ConsensusType{},
+		channelconfig.BatchSizeKey:           &ab.BatchSize{},
+		channelconfig.BatchTimeoutKey:        &ab.BatchTimeout{},
+		channelconfig.KafkaBrokersKey:        &ab.KafkaBrokers{},
+		channelconfig.ChannelRestrictionsKey: &ab.ChannelRestrictions{},
+		channelconfig.CapabilitiesKey:        &cb.Capabilities{},
+		channelconfig.OrderersKey:            &cb.Orderers{},
+	},
+	ordererOrgGroup: {
+		channelconfig.MSPKey:       &msp.MSPConfig{},
+		channelconfig.EndpointsKey: &cb.OrdererAddresses{},
+	},
+	applicationGroup: {
+		channelconfig.ACLsKey:         &pb.ACLs{},
+		channelconfig.CapabilitiesKey: &cb.Capabilities{},
+	},
+	applicationOrgGroup: {
+		channelconfig.MSPKey:         &msp.MSPConfig{},
+		channelconfig.AnchorPeersKey: &pb.AnchorPeers{},
+	},
+	consortiumGroup: {
+		channelconfig.ChannelCreationPolicyKey: &cb.Policy{},
+	},
+	consortiumOrgGroup: {
+		channelconfig.MSPKey: &msp.MSPConfig{},
+	},
+}
+
+func configValueMessage(_ proto.Message, s scope) proto.Message {
+	prototype, ok := configValues[s.groupType][s.key]
+	if !ok {
+		return nil
+	}
+
+	return newMessage(prototype)
+}
+
+func policyMessage(message proto.Message, _ scope) proto.Message {
+	policy, _ := message.(*cb.Policy)
+	switch cb.Policy_PolicyType(policy.GetType()) {
+	case cb.Policy_SIGNATURE:
+		return &cb.SignaturePolicyEnvelope{}
+	case cb.Policy_IMPLICIT_META:
+		return &cb.ImplicitMetaPolicy{}
+	default:
+		return nil
+	}
+}
+
+func principalMessage(message proto.Message, _ scope) proto.Message {
+	principal, _ := message.(*msp.MSPPrincipal)
+	switch principal.GetPrincipalClassification() {
+	case msp.MSPPrincipal_ROLE:
+		return &msp.MSPRole{}
+	case msp.MSPPrincipal_ORGANIZATION_UNIT:
+		return &msp.OrganizationUnit{}
+	case msp.MSPPrincipal_IDENTITY:
+		return &msp.SerializedIdentity{}
+	default:
+		return nil
+	}
+}
+
+func mspConfigMessage(message proto.Message, _ scope) proto.Message {
+	mspConfig, _ := message.(*msp.MSPConfig)
+	switch imsp.ProviderType(mspConfig.GetType()) {
+	case imsp.FABRIC:
+		return &msp.FabricMSPConfig{}
+	case imsp.IDEMIX:
+		return &msp.IdemixMSPConfig{}
+	default:
+		return nil
+	}
+}
+
+func consensusMetadataMessage(message proto.Message, _ scope) proto.Message {
+	consensusType, _ := message.(*ab.ConsensusType)
+	switch consensusType.GetType() {
+	case consensusTypeEtcdRaft:
+		return &etcdraft.ConfigMetadata{}
+	case consensusTypeBFT:
+		return &smartbft.Options{}
+	}
+
+	if len(consensusType.GetMetadata()) == 0 {
+		return &emptypb.Empty{}
+	}
+
+	return nil
+}
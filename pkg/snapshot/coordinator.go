@@ -0,0 +1,168 @@
+package snapshot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/fabric-admin-sdk/pkg/wait"
+)
+
+const (
+	defaultBlockMargin = 10
+	cancelTimeout      = 30 * time.Second
+)
+
+// Coordinator schedules snapshots of the same block on several peers, typically one peer from each organization, so
+// that their ledgers are captured at the same height.
+type Coordinator struct {
+	peers       []*Peer
+	blockMargin uint64
+}
+
+// CoordinatorOption implements an option for creating a new Coordinator.
+type CoordinatorOption func(*Coordinator)
+
+// WithBlockMargin specifies how many blocks beyond the highest current ledger height of the peers the snapshot block
+// is scheduled. The margin allows time to submit requests to all peers before the block is committed. The default
+// margin is 10 blocks.
+func WithBlockMargin(blocks uint64) CoordinatorOption {
+	return func(c *Coordinator) {
+		c.blockMargin = blocks
+	}
+}
+
+// NewCoordinator creates a coordinator for snapshots on the supplied peers.
+func NewCoordinator(peers []*Peer, options ...CoordinatorOption) *Coordinator {
+	result := &Coordinator{
+		peers:       peers,
+		blockMargin: defaultBlockMargin,
+	}
+
+	for _, option := range options {
+		option(result)
+	}
+
+	return result
+}
+
+// Progress of a snapshot on one peer.
+type Progress struct {
+	// Peer generating the snapshot.
+	Peer *Peer
+
+	// Height of the peer's ledger.
+	Height uint64
+
+	// Complete is true once the peer has committed the snapshot block and the snapshot request is no longer pending.
+	Complete bool
+}
+
+// Schedule picks a future block number based on the current ledger heights of the peers, and submits a snapshot
+// request for that block to every peer. If any peer rejects its request, requests already submitted to other peers
+// are cancelled and an error is returned. An error is also returned if the coordinator has no peers. The scheduled
+// block number is returned.
+func (c *Coordinator) Schedule(ctx context.Context, channelID string) (uint64, error) {
+	if len(c.peers) == 0 {
+		return 0, errors.New("no peers on which to schedule snapshot")
+	}
+
+	maxHeight, err := c.maxHeight(ctx, channelID)
+	if err != nil {
+		return 0, err
+	}
+
+	blockNum := maxHeight + c.blockMargin
+
+	for i, peer := range c.peers {
+		if err := peer.SubmitRequest(ctx, channelID, blockNum); err != nil {
+			err = fmt.Errorf("peer %d rejected snapshot request for block %d: %w", i, blockNum, err)
+			return 0, errors.Join(err, cancelRequests(ctx, c.peers[:i], channelID, blockNum))
+		}
+	}
+
+	return blockNum, nil
+}
+
+// Progress returns the progress of each peer in generating the snapshot for the specified block number, in the same
+// order as the peers supplied to NewCoordinator.
+func (c *Coordinator) Progress(ctx context.Context, channelID string, blockNum uint64) ([]*Progress, error) {
+	result := make([]*Progress, 0, len(c.peers))
+
+	for _, peer := range c.peers {
+		height, err := peer.height(ctx, channelID)
+		if err != nil {
+			return nil, err
+		}
+
+		complete := height > blockNum
+		if complete {
+			if complete, err = peer.isComplete(ctx, channelID, blockNum); err != nil {
+				return nil, err
+			}
+		}
+
+		result = append(result, &Progress{
+			Peer:     peer,
+			Height:   height,
+			Complete: complete,
+		})
+	}
+
+	return result, nil
+}
+
+// Wait waits concurrently on every peer until each has generated the snapshot for the specified block number. If
+// waiting on any peer fails, waiting on the other peers stops and the first error is returned. An error is returned
+// if a query fails or the context is done.
+func (c *Coordinator) Wait(ctx context.Context, channelID string, blockNum uint64, options ...wait.Option) error {
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	var wg sync.WaitGroup
+	for i, peer := range c.peers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := peer.WaitForSnapshot(ctx, channelID, blockNum, options...); err != nil {
+				cancel(fmt.Errorf("peer %d: %w", i, err))
+			}
+		}()
+	}
+
+	wg.Wait()
+	return context.Cause(ctx)
+}
+
+func (c *Coordinator) maxHeight(ctx context.Context, channelID string) (uint64, error) {
+	var result uint64
+
+	for _, peer := range c.peers {
+		height, err := peer.height(ctx, channelID)
+		if err != nil {
+			return 0, err
+		}
+
+		result = max(result, height)
+	}
+
+	return result, nil
+}
+
+// cancelRequests cancels snapshot requests on the supplied peers. Cancellation is attempted even if the context is
+// already done, so that requests are not left pending after a failed schedule.
+func cancelRequests(ctx context.Context, peers []*Peer, channelID string, blockNum uint64) error {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), cancelTimeout)
+	defer cancel()
+
+	var errs []error
+	for _, peer := range peers {
+		if err := peer.CancelRequest(ctx, channelID, blockNum); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,120 @@
+package snapshot_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hyperledger/fabric-admin-sdk/pkg/identity"
+	"github.com/hyperledger/fabric-admin-sdk/pkg/snapshot"
+	"github.com/hyperledger/fabric-admin-sdk/pkg/wait"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("Coordinator", func() {
+	var org1Admin, org2Admin identity.SigningIdentity
+
+	BeforeEach(func() {
+		org1Admin = NewTestOrgAdmin("org1.example.com", "Org1MSP")
+		org2Admin = NewTestOrgAdmin("org2.example.com", "Org2MSP")
+	})
+
+	NewPeers := func(fakes ...*FakePeer) []*snapshot.Peer {
+		controller := gomock.NewController(GinkgoT())
+		ids := []identity.SigningIdentity{org1Admin, org2Admin}
+
+		var result []*snapshot.Peer
+		for i, fake := range fakes {
+			result = append(result, snapshot.NewPeer(NewMockPeerConnection(controller, fake), ids[i%len(ids)]))
+		}
+		return result
+	}
+
+	It("Schedules same future block on all peers", func(specCtx SpecContext) {
+		// Heights advance by one on query, so current heights are 6 and 9
+		peer1 := &FakePeer{Height: 5}
+		peer2 := &FakePeer{Height: 8}
+		coordinator := snapshot.NewCoordinator(NewPeers(peer1, peer2), snapshot.WithBlockMargin(5))
+
+		actual, err := coordinator.Schedule(specCtx, "mychannel")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(actual).To(Equal(uint64(14)))
+		Expect(peer1.Submitted).To(Equal([]uint64{14}))
+		Expect(peer2.Submitted).To(Equal([]uint64{14}))
+	})
+
+	It("Cancels submitted requests if any peer rejects its request", func(specCtx SpecContext) {
+		expectedErr := errors.New("EXPECTED_ERROR")
+		peer1 := &FakePeer{Height: 5}
+		peer2 := &FakePeer{Height: 5}
+		peer3 := &FakePeer{Height: 5, SubmitErr: expectedErr}
+		coordinator := snapshot.NewCoordinator(NewPeers(peer1, peer2, peer3))
+
+		_, err := coordinator.Schedule(specCtx, "mychannel")
+
+		Expect(err).To(MatchError(expectedErr))
+		Expect(peer1.Cancelled).To(Equal(peer1.Submitted))
+		Expect(peer2.Cancelled).To(Equal(peer2.Submitted))
+		Expect(peer1.Pending).To(BeEmpty())
+		Expect(peer2.Pending).To(BeEmpty())
+		Expect(peer3.Cancelled).To(BeEmpty())
+	})
+
+	It("Fails to schedule without peers", func(specCtx SpecContext) {
+		coordinator := snapshot.NewCoordinator(nil)
+
+		_, err := coordinator.Schedule(specCtx, "mychannel")
+
+		Expect(err).To(MatchError(ContainSubstring("no peers")))
+	})
+
+	It("Reports progress of each peer", func(specCtx SpecContext) {
+		peer1 := &FakePeer{Height: 5, Pending: []uint64{10}}
+		peer2 := &FakePeer{Height: 20}
+		peers := NewPeers(peer1, peer2)
+		coordinator := snapshot.NewCoordinator(peers)
+
+		actual, err := coordinator.Progress(specCtx, "mychannel", 10)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(actual).To(HaveLen(2))
+		Expect(actual[0].Peer).To(BeIdenticalTo(peers[0]))
+		Expect(actual[0].Height).To(Equal(uint64(6)))
+		Expect(actual[0].Complete).To(BeFalse())
+		Expect(actual[1].Peer).To(BeIdenticalTo(peers[1]))
+		Expect(actual[1].Height).To(Equal(uint64(21)))
+		Expect(actual[1].Complete).To(BeTrue())
+	})
+
+	It("Waits for snapshot on all peers", func(specCtx SpecContext) {
+		peer1 := &FakePeer{Height: 5}
+		peer2 := &FakePeer{Height: 8}
+		coordinator := snapshot.NewCoordinator(NewPeers(peer1, peer2))
+
+		blockNum, err := coordinator.Schedule(specCtx, "mychannel")
+		Expect(err).NotTo(HaveOccurred())
+
+		err = coordinator.Wait(specCtx, "mychannel", blockNum, wait.WithBackoff(time.Millisecond, 5*time.Millisecond))
+		Expect(err).NotTo(HaveOccurred())
+
+		progress, err := coordinator.Progress(specCtx, "mychannel", blockNum)
+		Expect(err).NotTo(HaveOccurred())
+		for _, peerProgress := range progress {
+			Expect(peerProgress.Complete).To(BeTrue())
+		}
+	})
+
+	It("Wait ends when context is done", func(specCtx SpecContext) {
+		coordinator := snapshot.NewCoordinator(NewPeers(&FakePeer{Height: 5}, &FakePeer{Height: 5}))
+
+		ctx, cancel := context.WithTimeout(specCtx, 50*time.Millisecond)
+		defer cancel()
+
+		err := coordinator.Wait(ctx, "mychannel", 1000000, wait.WithBackoff(time.Millisecond, 5*time.Millisecond))
+
+		Expect(err).To(MatchError(context.DeadlineExceeded))
+	})
+})
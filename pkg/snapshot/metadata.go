@@ -0,0 +1,152 @@
+package snapshot
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+const (
+	// SignableMetadataFile is the name of the file in a snapshot directory that describes the snapshot and its files.
+	SignableMetadataFile = "_snapshot_signable_metadata.json"
+
+	// AdditionalMetadataFile is the name of the file in a snapshot directory that contains the hash of the signable
+	// metadata file.
+	AdditionalMetadataFile = "_snapshot_additional_metadata.json"
+)
+
+// SignableMetadata describes a completed snapshot. Its content is identical for snapshots of the same block on
+// different peers, and can be compared across peers to check that they hold the same ledger state.
+type SignableMetadata struct {
+	ChannelName       string `json:"channel_name"`
+	LastBlockNumber   uint64 `json:"last_block_number"`
+	LastBlockHash     string `json:"last_block_hash"`
+	PreviousBlockHash string `json:"previous_block_hash"`
+
+	// FileHashes maps each snapshot file name to the hex-encoded SHA-256 hash of its content.
+	FileHashes  map[string]string `json:"snapshot_files_raw_hashes"`
+	StateDBType string            `json:"state_db_type"`
+}
+
+// AdditionalMetadata contains peer-specific information about a completed snapshot.
+type AdditionalMetadata struct {
+	// SnapshotHash is the hex-encoded SHA-256 hash of the signable metadata file.
+	SnapshotHash        string `json:"snapshot_hash"`
+	LastBlockCommitHash string `json:"last_block_commit_hash"`
+}
+
+// CompletedSnapshotPath returns the directory containing a completed snapshot, within a peer's snapshot root directory
+// as specified by its ledger.snapshots.rootDir configuration.
+func CompletedSnapshotPath(snapshotRoot string, channelID string, blockNum uint64) string {
+	return filepath.Join(snapshotRoot, "completed", channelID, strconv.FormatUint(blockNum, 10))
+}
+
+// ReadMetadata reads the signable metadata from a completed snapshot directory, without verifying the snapshot files.
+func ReadMetadata(snapshotDir string) (*SignableMetadata, error) {
+	metadataBytes, err := os.ReadFile(filepath.Join(snapshotDir, SignableMetadataFile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot metadata: %w", err)
+	}
+
+	return unmarshalSignableMetadata(metadataBytes)
+}
+
+// Verify checks that a completed snapshot directory is intact, and returns its signable metadata. The hash of each
+// snapshot file must match the hash recorded in the signable metadata. If the additional metadata file is present, the
+// hash of the signable metadata file must match the snapshot hash it records.
+func Verify(snapshotDir string) (*SignableMetadata, error) {
+	metadataBytes, err := os.ReadFile(filepath.Join(snapshotDir, SignableMetadataFile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot metadata: %w", err)
+	}
+
+	if err := verifySnapshotHash(snapshotDir, metadataBytes); err != nil {
+		return nil, err
+	}
+
+	metadata, err := unmarshalSignableMetadata(metadataBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	for fileName, expectedHash := range metadata.FileHashes {
+		if err := verifyFileHash(snapshotDir, fileName, expectedHash); err != nil {
+			return nil, err
+		}
+	}
+
+	return metadata, nil
+}
+
+func unmarshalSignableMetadata(metadataBytes []byte) (*SignableMetadata, error) {
+	result := &SignableMetadata{}
+	if err := json.Unmarshal(metadataBytes, result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal snapshot metadata: %w", err)
+	}
+
+	return result, nil
+}
+
+func verifySnapshotHash(snapshotDir string, metadataBytes []byte) error {
+	additionalBytes, err := os.ReadFile(filepath.Join(snapshotDir, AdditionalMetadataFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read snapshot additional metadata: %w", err)
+	}
+
+	additional := &AdditionalMetadata{}
+	if err := json.Unmarshal(additionalBytes, additional); err != nil {
+		return fmt.Errorf("failed to unmarshal snapshot additional metadata: %w", err)
+	}
+
+	if err := compareHash(sha256.Sum256(metadataBytes), additional.SnapshotHash); err != nil {
+		return fmt.Errorf("%s: %w", SignableMetadataFile, err)
+	}
+
+	return nil
+}
+
+func verifyFileHash(snapshotDir string, fileName string, expectedHash string) error {
+	if fileName != filepath.Base(fileName) {
+		return fmt.Errorf("invalid snapshot file name: %s", fileName)
+	}
+
+	file, err := os.Open(filepath.Join(snapshotDir, fileName)) //#nosec G304 -- file name checked to be within snapshot directory
+	if err != nil {
+		return fmt.Errorf("failed to open snapshot file: %w", err)
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return fmt.Errorf("failed to read snapshot file: %w", err)
+	}
+
+	if err := compareHash([sha256.Size]byte(hash.Sum(nil)), expectedHash); err != nil {
+		return fmt.Errorf("%s: %w", fileName, err)
+	}
+
+	return nil
+}
+
+func compareHash(actual [sha256.Size]byte, expectedHex string) error {
+	expected, err := hex.DecodeString(expectedHex)
+	if err != nil {
+		return fmt.Errorf("invalid hash %q: %w", expectedHex, err)
+	}
+
+	if !bytes.Equal(actual[:], expected) {
+		return fmt.Errorf("hash %x does not match expected hash %s", actual, expectedHex)
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package snapshot_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/hyperledger/fabric-admin-sdk/pkg/snapshot"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func HexHash(content []byte) string {
+	hash := sha256.Sum256(content)
+	return hex.EncodeToString(hash[:])
+}
+
+func WriteFile(dir string, name string, content []byte) {
+	Expect(os.WriteFile(filepath.Join(dir, name), content, 0o600)).To(Succeed())
+}
+
+// WriteTestSnapshot writes a completed snapshot to a directory, with valid metadata for the supplied files.
+func WriteTestSnapshot(dir string, files map[string][]byte) *snapshot.SignableMetadata {
+	metadata := &snapshot.SignableMetadata{
+		ChannelName:       "mychannel",
+		LastBlockNumber:   100,
+		LastBlockHash:     "6c617374",
+		PreviousBlockHash: "70726576",
+		FileHashes:        map[string]string{},
+		StateDBType:       "goleveldb",
+	}
+
+	for name, content := range files {
+		WriteFile(dir, name, content)
+		metadata.FileHashes[name] = HexHash(content)
+	}
+
+	metadataBytes, err := json.Marshal(metadata)
+	Expect(err).NotTo(HaveOccurred())
+	WriteFile(dir, snapshot.SignableMetadataFile, metadataBytes)
+
+	additionalBytes, err := json.Marshal(&snapshot.AdditionalMetadata{
+		SnapshotHash:        HexHash(metadataBytes),
+		LastBlockCommitHash: "636f6d6d6974",
+	})
+	Expect(err).NotTo(HaveOccurred())
+	WriteFile(dir, snapshot.AdditionalMetadataFile, additionalBytes)
+
+	return metadata
+}
+
+var _ = Describe("Snapshot metadata", func() {
+	var snapshotDir string
+
+	BeforeEach(func() {
+		snapshotDir = GinkgoT().TempDir()
+	})
+
+	It("Completed snapshot path", func() {
+		actual := snapshot.CompletedSnapshotPath("/var/hyperledger/production/snapshots", "mychannel", 100)
+		Expect(actual).To(Equal(filepath.FromSlash("/var/hyperledger/production/snapshots/completed/mychannel/100")))
+	})
+
+	It("Reads metadata", func() {
+		expected := WriteTestSnapshot(snapshotDir, map[string][]byte{"txids.data": []byte("TXIDS")})
+
+		actual, err := snapshot.ReadMetadata(snapshotDir)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(actual).To(Equal(expected))
+	})
+
+	It("Verifies intact snapshot", func() {
+		expected := WriteTestSnapshot(snapshotDir, map[string][]byte{
+			"txids.data":        []byte("TXIDS"),
+			"public_state.data": []byte("STATE"),
+		})
+
+		actual, err := snapshot.Verify(snapshotDir)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(actual).To(Equal(expected))
+	})
+
+	It("Verifies snapshot without additional metadata", func() {
+		WriteTestSnapshot(snapshotDir, map[string][]byte{"txids.data": []byte("TXIDS")})
+		Expect(os.Remove(filepath.Join(snapshotDir, snapshot.AdditionalMetadataFile))).To(Succeed())
+
+		_, err := snapshot.Verify(snapshotDir)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Fails for corrupted snapshot file", func() {
+		WriteTestSnapshot(snapshotDir, map[string][]byte{"txids.data": []byte("TXIDS")})
+		WriteFile(snapshotDir, "txids.data", []byte("CORRUPTED"))
+
+		_, err := snapshot.Verify(snapshotDir)
+
+		Expect(err).To(MatchError(ContainSubstring("txids.data")))
+	})
+
+	It("Fails for missing snapshot file", func() {
+		WriteTestSnapshot(snapshotDir, map[string][]byte{"txids.data": []byte("TXIDS")})
+		Expect(os.Remove(filepath.Join(snapshotDir, "txids.data"))).To(Succeed())
+
+		_, err := snapshot.Verify(snapshotDir)
+
+		Expect(err).To(MatchError(os.ErrNotExist))
+	})
+
+	It("Fails for modified signable metadata", func() {
+		metadata := WriteTestSnapshot(snapshotDir, map[string][]byte{"txids.data": []byte("TXIDS")})
+		metadata.LastBlockNumber = 200
+		metadataBytes, err := json.Marshal(metadata)
+		Expect(err).NotTo(HaveOccurred())
+		WriteFile(snapshotDir, snapshot.SignableMetadataFile, metadataBytes)
+
+		_, err = snapshot.Verify(snapshotDir)
+
+		Expect(err).To(MatchError(ContainSubstring(snapshot.SignableMetadataFile)))
+	})
+
+	It("Fails for file outside snapshot directory", func() {
+		WriteTestSnapshot(snapshotDir, map[string][]byte{"txids.data": []byte("TXIDS")})
+		metadata := &snapshot.SignableMetadata{
+			FileHashes: map[string]string{"../outside.data": HexHash(nil)},
+		}
+		metadataBytes, err := json.Marshal(metadata)
+		Expect(err).NotTo(HaveOccurred())
+		WriteFile(snapshotDir, snapshot.SignableMetadataFile, metadataBytes)
+		Expect(os.Remove(filepath.Join(snapshotDir, snapshot.AdditionalMetadataFile))).To(Succeed())
+
+		_, err = snapshot.Verify(snapshotDir)
+
+		Expect(err).To(MatchError(ContainSubstring("invalid snapshot file name")))
+	})
+})
@@ -13,14 +13,16 @@ import (
 
 // Peer is a wrapper around snapshot client
 type Peer struct {
-	snapshot peer.SnapshotClient
-	id       identity.SigningIdentity
+	connection grpc.ClientConnInterface
+	snapshot   peer.SnapshotClient
+	id         identity.SigningIdentity
 }
 
 func NewPeer(conn grpc.ClientConnInterface, id identity.SigningIdentity) *Peer {
 	return &Peer{
-		snapshot: peer.NewSnapshotClient(conn),
-		id:       id,
+		connection: conn,
+		snapshot:   peer.NewSnapshotClient(conn),
+		id:         id,
 	}
 }
 
@@ -0,0 +1,89 @@
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hyperledger/fabric-admin-sdk/pkg/channel"
+	"github.com/hyperledger/fabric-admin-sdk/pkg/wait"
+)
+
+// lessThanLastCommittedMessage is part of the error message returned by the peer when a snapshot is requested for a
+// block before its last committed block.
+const lessThanLastCommittedMessage = "cannot be less than the last committed block"
+
+// SubmitAndWait submits a snapshot request for the specified block number, and waits until the snapshot is
+// generated. A block number of zero requests a snapshot of the last committed block. The number of the snapshot block
+// is returned. Once this function returns, the completed snapshot is available in the peer's snapshot directory, and
+// can be checked using Verify.
+func (p *Peer) SubmitAndWait(ctx context.Context, channelID string, blockNum uint64, options ...wait.Option) (uint64, error) {
+	var err error
+	if blockNum == 0 {
+		blockNum, err = p.submitLastCommitted(ctx, channelID)
+	} else {
+		err = p.SubmitRequest(ctx, channelID, blockNum)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	if err := p.WaitForSnapshot(ctx, channelID, blockNum, options...); err != nil {
+		return 0, err
+	}
+
+	return blockNum, nil
+}
+
+// WaitForSnapshot polls the peer, backing off between checks, until the snapshot for the specified block number is
+// generated. The snapshot is generated once the peer's ledger height has passed the block number, and the request is
+// no longer pending. An error is returned if a query fails or the context is done.
+func (p *Peer) WaitForSnapshot(ctx context.Context, channelID string, blockNum uint64, options ...wait.Option) error {
+	if _, err := channel.WaitForHeight(ctx, p.connection, p.id, channelID, blockNum+1, options...); err != nil {
+		return fmt.Errorf("failed waiting for block %d: %w", blockNum, err)
+	}
+
+	return wait.NewBackoff(options...).Until(ctx, func(ctx context.Context) (bool, error) {
+		return p.isComplete(ctx, channelID, blockNum)
+	})
+}
+
+// submitLastCommitted submits a snapshot request for the last committed block, and returns its block number. The block
+// number is resolved from the ledger height, so the request is retried if the peer rejects it because another block
+// was committed in the meantime.
+func (p *Peer) submitLastCommitted(ctx context.Context, channelID string) (uint64, error) {
+	for {
+		height, err := p.height(ctx, channelID)
+		if err != nil {
+			return 0, err
+		}
+
+		blockNum := height - 1
+		err = p.SubmitRequest(ctx, channelID, blockNum)
+		if err == nil {
+			return blockNum, nil
+		}
+		if !strings.Contains(err.Error(), lessThanLastCommittedMessage) {
+			return 0, err
+		}
+	}
+}
+
+func (p *Peer) isComplete(ctx context.Context, channelID string, blockNum uint64) (bool, error) {
+	pending, err := p.QueryPending(ctx, channelID)
+	if err != nil {
+		return false, err
+	}
+
+	return !slices.Contains(pending.GetBlockNumbers(), blockNum), nil
+}
+
+func (p *Peer) height(ctx context.Context, channelID string) (uint64, error) {
+	info, err := channel.GetBlockChainInfo(ctx, p.connection, p.id, channelID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get blockchain info: %w", err)
+	}
+
+	return info.GetHeight(), nil
+}
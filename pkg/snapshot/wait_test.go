@@ -0,0 +1,196 @@
+package snapshot_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/fabric-admin-sdk/pkg/identity"
+	"github.com/hyperledger/fabric-admin-sdk/pkg/snapshot"
+	"github.com/hyperledger/fabric-admin-sdk/pkg/wait"
+	cb "github.com/hyperledger/fabric-protos-go-apiv2/common"
+	pb "github.com/hyperledger/fabric-protos-go-apiv2/peer"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+//go:generate mockgen -destination ./clientconnection_mock_test.go -package ${GOPACKAGE} google.golang.org/grpc ClientConnInterface
+
+const (
+	processProposalMethod = "/protos.Endorser/ProcessProposal"
+	generateMethod        = "/protos.Snapshot/Generate"
+	cancelMethod          = "/protos.Snapshot/Cancel"
+	queryPendingsMethod   = "/protos.Snapshot/QueryPendings"
+)
+
+func NewTestOrgAdmin(org string, mspID string) identity.SigningIdentity {
+	mspDir := filepath.Join("..", "..", "test", "data", "peerOrganizations", org, "users", "Admin@"+org, "msp")
+
+	certificate, err := identity.ReadCertificate(filepath.Join(mspDir, "signcerts", "Admin@"+org+"-cert.pem"))
+	Expect(err).NotTo(HaveOccurred())
+
+	privateKey, err := identity.ReadPrivateKey(filepath.Join(mspDir, "keystore", "priv_sk"))
+	Expect(err).NotTo(HaveOccurred())
+
+	result, err := identity.NewPrivateKeySigningIdentity(mspID, certificate, privateKey)
+	Expect(err).NotTo(HaveOccurred())
+	return result
+}
+
+func AssertMarshal(message proto.Message) []byte {
+	result, err := proto.Marshal(message)
+	Expect(err).NotTo(HaveOccurred())
+	return result
+}
+
+// FakePeer simulates the ledger height and snapshot requests of a peer. Each ledger height query advances the height
+// by one block, and snapshot requests complete once the height passes their block number. CommitsBeforeSubmit blocks
+// are committed on receipt of the first snapshot requests, before they are checked against the ledger height.
+type FakePeer struct {
+	lock                sync.Mutex
+	Height              uint64
+	Pending             []uint64
+	Submitted           []uint64
+	Cancelled           []uint64
+	SubmitErr           error
+	CommitsBeforeSubmit int
+}
+
+func (f *FakePeer) invoke(method string, in any, out any) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	switch method {
+	case processProposalMethod:
+		f.Height++
+		f.Pending = slices.DeleteFunc(f.Pending, func(blockNum uint64) bool { return blockNum < f.Height })
+		response := &pb.ProposalResponse{
+			Response: &pb.Response{
+				Status:  int32(cb.Status_SUCCESS),
+				Payload: AssertMarshal(&cb.BlockchainInfo{Height: f.Height}),
+			},
+		}
+		proto.Merge(out.(*pb.ProposalResponse), response)
+	case generateMethod:
+		if f.SubmitErr != nil {
+			return f.SubmitErr
+		}
+		if f.CommitsBeforeSubmit > 0 {
+			f.CommitsBeforeSubmit--
+			f.Height++
+		}
+		blockNum := RequestBlockNumber(in)
+		if blockNum+1 < f.Height {
+			return fmt.Errorf("requested snapshot for block number %d cannot be less than the last committed block number %d", blockNum, f.Height-1)
+		}
+		f.Submitted = append(f.Submitted, blockNum)
+		f.Pending = append(f.Pending, blockNum)
+	case cancelMethod:
+		blockNum := RequestBlockNumber(in)
+		f.Cancelled = append(f.Cancelled, blockNum)
+		f.Pending = slices.DeleteFunc(f.Pending, func(n uint64) bool { return n == blockNum })
+	case queryPendingsMethod:
+		proto.Merge(out.(*pb.QueryPendingSnapshotsResponse), &pb.QueryPendingSnapshotsResponse{
+			BlockNumbers: slices.Clone(f.Pending),
+		})
+	default:
+		Fail("Unexpected method: " + method)
+	}
+
+	return nil
+}
+
+func RequestBlockNumber(in any) uint64 {
+	request := &pb.SnapshotRequest{}
+	Expect(proto.Unmarshal(in.(*pb.SignedSnapshotRequest).GetRequest(), request)).To(Succeed())
+	return request.GetBlockNumber()
+}
+
+func NewMockPeerConnection(controller *gomock.Controller, fake *FakePeer) *MockClientConnInterface {
+	connection := NewMockClientConnInterface(controller)
+	connection.EXPECT().
+		Invoke(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, method string, in any, out any, opts ...grpc.CallOption) error {
+			return fake.invoke(method, in, out)
+		}).
+		AnyTimes()
+
+	return connection
+}
+
+var _ = Describe("SubmitAndWait", func() {
+	var id identity.SigningIdentity
+
+	BeforeEach(func() {
+		id = NewTestOrgAdmin("org1.example.com", "Org1MSP")
+	})
+
+	It("Waits until snapshot block is reached and request is no longer pending", func(specCtx SpecContext) {
+		fake := &FakePeer{Height: 5}
+		controller := gomock.NewController(GinkgoT())
+		peer := snapshot.NewPeer(NewMockPeerConnection(controller, fake), id)
+
+		actual, err := peer.SubmitAndWait(specCtx, "mychannel", 10, wait.WithBackoff(time.Millisecond, 5*time.Millisecond))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(actual).To(Equal(uint64(10)))
+		Expect(fake.Submitted).To(Equal([]uint64{10}))
+		Expect(fake.Height).To(BeNumerically(">", 10))
+		Expect(fake.Pending).To(BeEmpty())
+	})
+
+	It("Block number zero uses last committed block", func(specCtx SpecContext) {
+		fake := &FakePeer{Height: 7}
+		controller := gomock.NewController(GinkgoT())
+		peer := snapshot.NewPeer(NewMockPeerConnection(controller, fake), id)
+
+		actual, err := peer.SubmitAndWait(specCtx, "mychannel", 0, wait.WithBackoff(time.Millisecond, 5*time.Millisecond))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(actual).To(Equal(uint64(7)))
+		Expect(fake.Submitted).To(Equal([]uint64{7}))
+	})
+
+	It("Block number zero retries if a block is committed before the request", func(specCtx SpecContext) {
+		fake := &FakePeer{Height: 7, CommitsBeforeSubmit: 1}
+		controller := gomock.NewController(GinkgoT())
+		peer := snapshot.NewPeer(NewMockPeerConnection(controller, fake), id)
+
+		actual, err := peer.SubmitAndWait(specCtx, "mychannel", 0, wait.WithBackoff(time.Millisecond, 5*time.Millisecond))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(actual).To(Equal(uint64(9)))
+		Expect(fake.Submitted).To(Equal([]uint64{9}))
+	})
+
+	It("Returns submit errors", func(specCtx SpecContext) {
+		expectedErr := errors.New("EXPECTED_ERROR")
+		fake := &FakePeer{Height: 5, SubmitErr: expectedErr}
+		controller := gomock.NewController(GinkgoT())
+		peer := snapshot.NewPeer(NewMockPeerConnection(controller, fake), id)
+
+		_, err := peer.SubmitAndWait(specCtx, "mychannel", 10)
+
+		Expect(err).To(MatchError(expectedErr))
+	})
+
+	It("Ends when context is done", func(specCtx SpecContext) {
+		fake := &FakePeer{Height: 5}
+		controller := gomock.NewController(GinkgoT())
+		peer := snapshot.NewPeer(NewMockPeerConnection(controller, fake), id)
+
+		ctx, cancel := context.WithTimeout(specCtx, 50*time.Millisecond)
+		defer cancel()
+
+		_, err := peer.SubmitAndWait(ctx, "mychannel", 1000000, wait.WithBackoff(time.Millisecond, 5*time.Millisecond))
+
+		Expect(err).To(MatchError(context.DeadlineExceeded))
+	})
+})
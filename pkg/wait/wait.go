@@ -0,0 +1,93 @@
+/*
+Copyright IBM Corp. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package wait provides the options shared by functions that poll for a condition, backing off between checks.
+package wait
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	// DefaultInitialInterval is the interval before the second check of a condition, if none is specified.
+	DefaultInitialInterval = 250 * time.Millisecond
+
+	// DefaultMaxInterval is the largest interval between checks of a condition, if none is specified.
+	DefaultMaxInterval = 5 * time.Second
+)
+
+// Backoff defines the intervals between repeated checks of a condition. The interval starts at Initial and doubles
+// after each check, up to Max.
+type Backoff struct {
+	Initial time.Duration
+	Max     time.Duration
+}
+
+// Option implements an option for waiting on a condition.
+type Option func(*Backoff)
+
+// WithBackoff specifies the intervals between checks while waiting. The interval starts at initial and doubles after
+// each check, up to maxInterval. The default is 250 milliseconds, up to 5 seconds.
+func WithBackoff(initial time.Duration, maxInterval time.Duration) Option {
+	return func(b *Backoff) {
+		b.Initial = initial
+		b.Max = maxInterval
+	}
+}
+
+// NewBackoff returns the default backoff, modified by the supplied options. A non-positive interval is replaced by its
+// default value, and the initial interval is limited to the maximum interval.
+func NewBackoff(options ...Option) Backoff {
+	result := Backoff{
+		Initial: DefaultInitialInterval,
+		Max:     DefaultMaxInterval,
+	}
+
+	for _, option := range options {
+		option(&result)
+	}
+
+	if result.Max <= 0 {
+		result.Max = DefaultMaxInterval
+	}
+	if result.Initial <= 0 {
+		result.Initial = DefaultInitialInterval
+	}
+	result.Initial = min(result.Initial, result.Max)
+
+	return result
+}
+
+// Until calls check repeatedly, backing off between calls, until it reports that the condition holds, returns an
+// error, or the context is done.
+func (b Backoff) Until(ctx context.Context, check func(context.Context) (bool, error)) error {
+	interval := b.Initial
+
+	for {
+		done, err := check(ctx)
+		if err != nil || done {
+			return err
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		interval = b.next(interval)
+	}
+}
+
+func (b Backoff) next(interval time.Duration) time.Duration {
+	interval *= 2
+	if interval > b.Max {
+		return b.Max
+	}
+	return interval
+}
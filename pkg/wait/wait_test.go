@@ -0,0 +1,49 @@
+package wait_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/hyperledger/fabric-admin-sdk/pkg/wait"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Backoff", func() {
+	DescribeTable("Applies options",
+		func(option wait.Option, expected wait.Backoff) {
+			Expect(wait.NewBackoff(option)).To(Equal(expected))
+		},
+		Entry("Specified intervals", wait.WithBackoff(time.Millisecond, 5*time.Millisecond), wait.Backoff{Initial: time.Millisecond, Max: 5 * time.Millisecond}),
+		Entry("Zero initial interval", wait.WithBackoff(0, 5*time.Second), wait.Backoff{Initial: wait.DefaultInitialInterval, Max: 5 * time.Second}),
+		Entry("Negative initial interval", wait.WithBackoff(-time.Second, 5*time.Second), wait.Backoff{Initial: wait.DefaultInitialInterval, Max: 5 * time.Second}),
+		Entry("Zero maximum interval", wait.WithBackoff(time.Millisecond, 0), wait.Backoff{Initial: time.Millisecond, Max: wait.DefaultMaxInterval}),
+		Entry("Initial interval above maximum interval", wait.WithBackoff(time.Second, 10*time.Millisecond), wait.Backoff{Initial: 10 * time.Millisecond, Max: 10 * time.Millisecond}),
+	)
+
+	It("Uses default intervals without options", func() {
+		Expect(wait.NewBackoff()).To(Equal(wait.Backoff{Initial: wait.DefaultInitialInterval, Max: wait.DefaultMaxInterval}))
+	})
+
+	It("Checks until condition holds", func(specCtx SpecContext) {
+		var checks int
+		err := wait.NewBackoff(wait.WithBackoff(time.Millisecond, 5*time.Millisecond)).Until(specCtx, func(context.Context) (bool, error) {
+			checks++
+			return checks == 3, nil
+		})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(checks).To(Equal(3))
+	})
+
+	It("Ends when context is done", func(specCtx SpecContext) {
+		ctx, cancel := context.WithTimeout(specCtx, 50*time.Millisecond)
+		defer cancel()
+
+		err := wait.NewBackoff(wait.WithBackoff(time.Millisecond, 5*time.Millisecond)).Until(ctx, func(context.Context) (bool, error) {
+			return false, nil
+		})
+
+		Expect(err).To(MatchError(context.DeadlineExceeded))
+	})
+})
@@ -5,11 +5,9 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"fmt"
-	"log"
 	"os"
 
 	"github.com/hyperledger/fabric-admin-sdk/internal/protoutil"
-	"github.com/hyperledger/fabric-protos-go-apiv2/orderer"
 
 	"github.com/hyperledger/fabric-admin-sdk/pkg/channel"
 	"github.com/hyperledger/fabric-admin-sdk/pkg/identity"
@@ -127,7 +125,6 @@ var _ = Describe("channel", func() {
 			if err != nil {
 				Skip("skip for unit test")
 			}
-			var channelID = "mychannel"
 			// Orderer
 			var OrdererAddr = "localhost:7050"
 			var OrdererTLSCACert = "../fabric-samples/test-network/organizations/ordererOrganizations/example.com/tlsca/tlsca.example.com-cert.pem"
@@ -165,13 +162,15 @@ var _ = Describe("channel", func() {
 			Expect(err).NotTo(HaveOccurred())
 			envelope, err := protoutil.UnmarshalEnvelope(updateEnvelope)
 			Expect(err).NotTo(HaveOccurred())
+			configUpdate, err := channel.ConfigUpdateFromEnvelope(envelope)
+			Expect(err).NotTo(HaveOccurred())
 
 			// Peer1 sign
-			envelope, err = SignConfigTx(channelID, envelope, signer)
+			err = configUpdate.Sign(signer)
 			Expect(err).NotTo(HaveOccurred())
 
 			// Peer2 sign
-			envelope, err = SignConfigTx(channelID, envelope, signer2)
+			err = configUpdate.Sign(signer2)
 			Expect(err).NotTo(HaveOccurred())
 
 			ordererNode := network.Node{
@@ -182,16 +181,8 @@ var _ = Describe("channel", func() {
 			Expect(err).NotTo(HaveOccurred())
 			ordererConnection, err := network.DialConnection(ordererNode)
 			Expect(err).NotTo(HaveOccurred())
-			ordererClient, err := orderer.NewAtomicBroadcastClient(ordererConnection).Broadcast(context.Background())
-			Expect(err).NotTo(HaveOccurred())
-			defer func(ordererClient orderer.AtomicBroadcast_BroadcastClient) {
-				_ = ordererClient.CloseSend()
-			}(ordererClient)
-			err = ordererClient.Send(envelope)
-			Expect(err).NotTo(HaveOccurred())
-			response, err := ordererClient.Recv()
+			err = configUpdate.Submit(context.Background(), ordererConnection, signer)
 			Expect(err).NotTo(HaveOccurred())
-			log.Println("response: ", response.String())
 		})
 	})
 })